@@ -0,0 +1,14 @@
+// Package api holds the HTTP/JSON API definitions of the service. The routes
+// are set by catalog_service/catalog_http.yaml, which the gateway and the
+// OpenAPI spec are generated from.
+package api
+
+import (
+	// embeds the OpenAPI spec
+	_ "embed"
+)
+
+// CatalogOpenAPI is the OpenAPI 2 spec of the CatalogService HTTP/JSON API
+//
+//go:embed catalog_service/catalog.swagger.json
+var CatalogOpenAPI []byte
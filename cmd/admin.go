@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/abdullohsattorov/catalog-service/config"
+	"github.com/abdullohsattorov/catalog-service/pkg/audit"
+	"github.com/abdullohsattorov/catalog-service/pkg/db"
+	"github.com/abdullohsattorov/catalog-service/service/exporter"
+	"github.com/abdullohsattorov/catalog-service/service/importer"
+	"github.com/abdullohsattorov/catalog-service/storage"
+)
+
+const usage = `usage:
+  catalog                              run the grpc server
+  catalog import [flags] <file>        import books from a CSV file or an ONIX 3.0 feed
+  catalog export [flags] <file>        export books to a CSV or MARC file, - for stdout`
+
+// runCommand runs the admin command named by args instead of the server.
+// Commands only need the database, none of the server's background workers
+// or downstream connections are started for them.
+func runCommand(cfg config.Config, args []string) error {
+	var run func([]string, storage.IStorage) error
+	switch args[0] {
+	case "import":
+		run = runImport
+	case "export":
+		run = runExport
+	default:
+		return fmt.Errorf("unknown command %q\n%s", args[0], usage)
+	}
+
+	connDB, err := db.ConnectToDB(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to connect to postgres: %w", err)
+	}
+	defer connDB.Close()
+
+	return run(args[1:], storage.NewStoragePg(connDB))
+}
+
+func runImport(args []string, store storage.IStorage) error {
+	var (
+		flags = flag.NewFlagSet("import", flag.ContinueOnError)
+		opts  importer.Options
+	)
+	flags.BoolVar(&opts.DryRun, "dry-run", false, "only validate the file")
+	flags.BoolVar(&opts.CreateMissing, "create-missing", false, "create authors and categories which aren't found by name")
+	actor := flags.String("actor", "catalog-cli", "who the changes are audited as")
+	format := flags.String("format", "csv", "format of the file: csv or onix")
+	currency := flags.String("currency", "USD", "currency of prices taken from ONIX feeds")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errors.New(usage)
+	}
+
+	f, err := os.Open(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var (
+		position  = "row"
+		records   []importer.Record
+		rowErrors []importer.RowError
+		unmapped  []importer.UnmappedField
+	)
+	switch *format {
+	case "csv":
+		records, rowErrors, err = importer.ReadCSV(f)
+	case "onix":
+		position = "product"
+		records, rowErrors, unmapped, err = importer.ReadONIX(f, *currency)
+	default:
+		return fmt.Errorf("unknown format %q", *format)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", flags.Arg(0), err)
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(audit.ActorHeader, *actor))
+	report, err := importer.New(store, opts).Import(ctx, records, rowErrors)
+	if err != nil {
+		return err
+	}
+
+	for _, field := range unmapped {
+		fmt.Printf("unmapped %s: %d\n", field.Path, field.Count)
+	}
+	for _, rowErr := range report.Errors {
+		fmt.Printf("%s %d: %s\n", position, rowErr.Row, rowErr.Message)
+	}
+	if report.DryRun {
+		fmt.Print("dry run, nothing was written\n")
+	}
+	fmt.Printf("created %d, updated %d, unchanged %d books, created %d authors and %d categories\n",
+		report.Created, report.Updated, report.Unchanged, report.CreatedAuthors, report.CreatedCategories)
+
+	if len(report.Errors) > 0 {
+		if len(report.Applied) > 0 {
+			return fmt.Errorf("%d %ss failed, the other %d were imported", len(report.Errors), position, len(report.Applied))
+		}
+		return fmt.Errorf("%d %ss failed, nothing was imported", len(report.Errors), position)
+	}
+
+	return nil
+}
+
+func runExport(args []string, store storage.IStorage) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	format := flags.String("format", "csv", "format of the file: csv, marc21 or marcxml")
+	currency := flags.String("currency", "USD", "currency of the prices written to MARC records")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errors.New(usage)
+	}
+
+	write := func(w io.Writer) error {
+		switch *format {
+		case "csv":
+			return exporter.New(store, *currency).WriteCSV(context.Background(), w)
+		case exporter.FormatMarc21, exporter.FormatMarcXML:
+			return exporter.New(store, *currency).WriteMarc(context.Background(), w, *format)
+		default:
+			return fmt.Errorf("unknown format %q", *format)
+		}
+	}
+
+	if flags.Arg(0) == "-" {
+		return write(os.Stdout)
+	}
+
+	f, err := os.Create(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	if err = write(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/abdullohsattorov/catalog-service/api"
+	"github.com/abdullohsattorov/catalog-service/config"
+	"github.com/abdullohsattorov/catalog-service/pkg/gateway"
+	"github.com/abdullohsattorov/catalog-service/pkg/logger"
+	"github.com/abdullohsattorov/catalog-service/pkg/tlsutil"
+)
+
+// serveGateway serves the HTTP/JSON API and its OpenAPI spec, proxying
+// requests to the grpc server of this process with secret
+func serveGateway(ctx context.Context, cfg config.Config, secret string, log logger.Logger) *http.Server {
+	creds := insecure.NewCredentials()
+	if cfg.TLSCertFile != "" {
+		tlsCfg, err := tlsutil.ClientConfig(tlsutil.ClientOptions{
+			CAFile:     cfg.HTTPGatewayCAFile,
+			CertFile:   cfg.HTTPGatewayCertFile,
+			KeyFile:    cfg.HTTPGatewayKeyFile,
+			ServerName: cfg.HTTPGatewayServerName,
+		}, log)
+		if err != nil {
+			log.Fatal("gateway tls error", logger.Error(err))
+		}
+		creds = credentials.NewTLS(tlsCfg)
+	}
+
+	endpoint := cfg.RPCPort
+	if strings.HasPrefix(endpoint, ":") {
+		endpoint = "localhost" + endpoint
+	}
+
+	gw, err := gateway.New(ctx, endpoint, creds, secret)
+	if err != nil {
+		log.Fatal("gateway error", logger.Error(err))
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", gw)
+	mux.HandleFunc("/openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(api.CatalogOpenAPI)
+	})
+
+	server := &http.Server{Addr: cfg.HTTPPort, Handler: mux}
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("gateway server error", logger.Error(err))
+		}
+	}()
+
+	return server
+}
+
+// newGatewaySecret returns the secret the gateway sends with its calls, a new
+// one on every start since only this process needs to know it
+func newGatewaySecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(secret), nil
+}
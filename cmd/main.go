@@ -1,23 +1,48 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/abdullohsattorov/catalog-service/config"
 	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	"github.com/abdullohsattorov/catalog-service/pkg/auth"
 	"github.com/abdullohsattorov/catalog-service/pkg/db"
+	"github.com/abdullohsattorov/catalog-service/pkg/events"
+	"github.com/abdullohsattorov/catalog-service/pkg/health"
 	"github.com/abdullohsattorov/catalog-service/pkg/logger"
+	"github.com/abdullohsattorov/catalog-service/pkg/metrics"
+	"github.com/abdullohsattorov/catalog-service/pkg/ratelimit"
+	"github.com/abdullohsattorov/catalog-service/pkg/tlsutil"
+	"github.com/abdullohsattorov/catalog-service/pkg/tracing"
 	"github.com/abdullohsattorov/catalog-service/service"
+	grpcClient "github.com/abdullohsattorov/catalog-service/service/grpc_client"
 	"github.com/abdullohsattorov/catalog-service/storage"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	healthPb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
+// catalogServiceName is the name CatalogService reports its health under
+const catalogServiceName = "catalog.CatalogService"
+
 func main() {
 	cfg := config.Load()
 
-	log := logger.New(cfg.LogLevel, "catalog-service")
+	log := logger.New(cfg.LogLevel, "catalog-service",
+		logger.WithEncoding(cfg.LogEncoding),
+		logger.WithSampling(cfg.LogSamplingInitial, cfg.LogSamplingThereafter),
+	)
 	defer func(l logger.Logger) {
 		err := logger.Cleanup(l)
 		if err != nil {
@@ -25,6 +50,35 @@ func main() {
 		}
 	}(log)
 
+	if len(os.Args) > 1 {
+		if err := runCommand(cfg, os.Args[1:]); err != nil {
+			// Fatal would exit before the deferred cleanup runs
+			log.Error("command failed", logger.Error(err))
+			_ = logger.Cleanup(log)
+			os.Exit(1)
+		}
+		return
+	}
+
+	tracerProvider, err := tracing.Init(context.Background(), tracing.Options{
+		ServiceName: "catalog-service",
+		Exporter:    cfg.TracingExporter,
+		Endpoint:    cfg.TracingEndpoint,
+		Insecure:    cfg.TracingInsecure,
+		SampleRatio: cfg.TracingSampleRatio,
+	})
+	if err != nil {
+		log.Fatal("tracing error", logger.Error(err))
+	}
+	defer func() {
+		// spans still buffered are flushed
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := tracerProvider.Shutdown(ctx); err != nil {
+			log.Error("failed to shut down tracing", logger.Error(err))
+		}
+	}()
+
 	log.Info("main: sqlxConfig",
 		logger.String("host", cfg.PostgresHost),
 		logger.Int("port", cfg.PostgresPort),
@@ -34,23 +88,240 @@ func main() {
 	if err != nil {
 		log.Fatal("sqlx connection to postgres error", logger.Error(err))
 	}
+	defer func() {
+		if err := connDB.Close(); err != nil {
+			log.Error("failed to close postgres connections", logger.Error(err))
+		}
+	}()
+
+	m := metrics.New(connDB.DB, cfg.PostgresDatabase)
+	pgStorage := storage.Instrument(storage.NewStoragePg(connDB), m)
+
+	publisher, err := events.NewPublisher(cfg.EventPublisher, cfg.EventFile)
+	if err != nil {
+		log.Fatal("event publisher error", logger.Error(err))
+	}
+	defer publisher.Close()
+
+	// background workers run until the server has stopped, they are
+	// stopped before the connections they use are closed
+	var (
+		workers                 sync.WaitGroup
+		workersCtx, stopWorkers = context.WithCancel(context.Background())
+	)
+
+	relay := events.NewRelay(pgStorage.Outbox(), publisher, log, events.RelayOptions{
+		Interval:       cfg.OutboxPollInterval,
+		BatchSize:      cfg.OutboxBatchSize,
+		PublishTimeout: cfg.OutboxPublishTimeout,
+		Retention:      cfg.OutboxRetention,
+	})
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		relay.Run(workersCtx)
+	}()
+
+	hub := events.NewHub(pgStorage.Outbox())
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		if err := hub.Listen(workersCtx, db.ConnString(cfg), log); err != nil {
+			log.Error("catalog events listener error", logger.Error(err))
+		}
+	}()
+
+	client, err := grpcClient.New(cfg, log)
+	if err != nil {
+		log.Fatal("grpc client dial error", logger.Error(err))
+	}
+	defer client.Close()
+	defer func() {
+		stopWorkers()
+		workers.Wait()
+	}()
 
-	pgStorage := storage.NewStoragePg(connDB)
+	catalogService := service.NewCatalogService(cfg, pgStorage, log, client, hub)
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		catalogService.PurgeIdempotencyKeys(workersCtx)
+	}()
 
-	catalogService := service.NewCatalogService(pgStorage, log)
+	checker := health.NewChecker(connDB, client, cfg.HealthCheckInterval, log, catalogServiceName)
+	workers.Add(1)
+	go func() {
+		defer workers.Done()
+		checker.Run(workersCtx)
+	}()
 
 	lis, err := net.Listen("tcp", cfg.RPCPort)
 	if err != nil {
 		log.Fatal("Error while listening: %v", logger.Error(err))
 	}
 
-	s := grpc.NewServer()
+	unary := []grpc.UnaryServerInterceptor{
+		otelgrpc.UnaryServerInterceptor(),
+		logger.UnaryServerInterceptor(log),
+		m.UnaryServerInterceptor,
+	}
+	stream := []grpc.StreamServerInterceptor{
+		otelgrpc.StreamServerInterceptor(),
+		logger.StreamServerInterceptor(log),
+		m.StreamServerInterceptor,
+	}
+
+	if cfg.AuthEnabled {
+		authenticator, err := auth.New(auth.Config{
+			HMACSecret:       cfg.AuthHMACSecret,
+			RSAPublicKeyFile: cfg.AuthRSAPublicKeyFile,
+			JWKSFile:         cfg.AuthJWKSFile,
+			Issuer:           cfg.AuthIssuer,
+			Audience:         cfg.AuthAudience,
+			RolesClaim:       cfg.AuthRolesClaim,
+		})
+		if err != nil {
+			log.Fatal("auth error", logger.Error(err))
+		}
+		unary = append(unary, authenticator.UnaryServerInterceptor(service.Permissions))
+		stream = append(stream, authenticator.StreamServerInterceptor(service.Permissions))
+	} else {
+		log.Warn("main: authentication is disabled, any client can change the catalog")
+	}
+
+	// limited after auth so authenticated clients are told apart by subject
+	methodLimits, err := ratelimit.ParseLimits(cfg.RateLimitMethods)
+	if err != nil {
+		log.Fatal("rate limit error", logger.Error(err))
+	}
+	gatewaySecret, err := newGatewaySecret()
+	if err != nil {
+		log.Fatal("gateway secret error", logger.Error(err))
+	}
+	limiter := ratelimit.New(ratelimit.Config{
+		Default:       ratelimit.Limit{Rate: cfg.RateLimitRPS, Burst: cfg.RateLimitBurst},
+		Methods:       methodLimits,
+		MaxInFlight:   cfg.MaxInFlight,
+		GatewaySecret: gatewaySecret,
+	})
+	unary = append(unary, limiter.UnaryServerInterceptor)
+	stream = append(stream, limiter.StreamServerInterceptor)
+
+	// replayed responses are only given to callers allowed to make the request
+	unary = append(unary, catalogService.IdempotencyInterceptor)
+
+	serverOpts := []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	}
+	if cfg.TLSCertFile != "" {
+		tlsCfg, err := tlsutil.ServerConfig(tlsutil.ServerOptions{
+			CertFile:     cfg.TLSCertFile,
+			KeyFile:      cfg.TLSKeyFile,
+			ClientCAFile: cfg.TLSClientCAFile,
+		}, log)
+		if err != nil {
+			log.Fatal("tls error", logger.Error(err))
+		}
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsCfg)))
+	} else {
+		log.Warn("main: tls is disabled, the server accepts plaintext connections")
+	}
+
+	s := grpc.NewServer(serverOpts...)
 	pb.RegisterCatalogServiceServer(s, catalogService)
+	healthPb.RegisterHealthServer(s, checker.Server())
 	reflection.Register(s)
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", m.Handler())
+	metricsServer := &http.Server{Addr: cfg.MetricsPort, Handler: mux}
+	go func() {
+		if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error("metrics server error", logger.Error(err))
+		}
+	}()
+
+	// the log level can be changed by anyone reaching it, so it isn't served
+	// next to the metrics, which are scraped from outside
+	var adminServer *http.Server
+	if cfg.AdminPort != "" {
+		adminMux := http.NewServeMux()
+		adminMux.Handle("/log/level", log.LevelHandler())
+		adminServer = &http.Server{Addr: cfg.AdminPort, Handler: adminMux}
+		go func() {
+			if err := adminServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Error("admin server error", logger.Error(err))
+			}
+		}()
+	}
+
+	gatewayCtx, stopGateway := context.WithCancel(context.Background())
+	defer stopGateway()
+	var gatewayServer *http.Server
+	if cfg.HTTPPort != "" {
+		gatewayServer = serveGateway(gatewayCtx, cfg, gatewaySecret, log)
+	}
+
 	log.Info("main: server running",
-		logger.String("port", cfg.RPCPort))
+		logger.String("port", cfg.RPCPort),
+		logger.String("metrics_port", cfg.MetricsPort),
+		logger.String("admin_port", cfg.AdminPort),
+		logger.String("http_port", cfg.HTTPPort))
 
-	if err := s.Serve(lis); err != nil {
-		log.Fatal("Error while listening: %v", logger.Error(err))
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- s.Serve(lis)
+	}()
+
+	select {
+	case err := <-serveErr:
+		log.Error("grpc server stopped", logger.Error(err))
+	case <-ctx.Done():
+		log.Info("main: shutting down")
+	}
+
+	shutdown(s, checker, hub, cfg.ShutdownTimeout, log)
+
+	// requests the gateway still proxies have failed once the server stopped
+	if gatewayServer != nil {
+		if err := gatewayServer.Close(); err != nil {
+			log.Error("failed to close gateway server", logger.Error(err))
+		}
+	}
+
+	// metrics are served until the server has stopped, so the drain is
+	// scraped too
+	if err := metricsServer.Close(); err != nil {
+		log.Error("failed to close metrics server", logger.Error(err))
+	}
+	if adminServer != nil {
+		if err := adminServer.Close(); err != nil {
+			log.Error("failed to close admin server", logger.Error(err))
+		}
+	}
+}
+
+// shutdown stops the server from accepting new RPCs and waits for in-flight
+// ones until timeout, then cancels the rest. Probes see NOT_SERVING and
+// change feed streams are ended first, since they would never finish.
+func shutdown(s *grpc.Server, checker *health.Checker, hub *events.Hub, timeout time.Duration, log logger.Logger) {
+	checker.Shutdown()
+	hub.Close()
+
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		log.Info("main: server stopped")
+	case <-time.After(timeout):
+		log.Warn("main: shutdown timed out, cancelling in-flight requests", logger.String("timeout", timeout.String()))
+		s.Stop()
 	}
 }
@@ -2,6 +2,7 @@ package config
 
 import (
 	"os"
+	"time"
 
 	"github.com/spf13/cast"
 )
@@ -18,6 +19,134 @@ type Config struct {
 	RPCPort           string
 	ReviewServiceHost string
 	ReviewServicePort int
+	// ReviewServiceFake serves ratings from an in-process fake instead of
+	// dialing review_service, for local development
+	ReviewServiceFake bool
+	// ReviewCacheTTL is how long book ratings are cached
+	ReviewCacheTTL time.Duration
+
+	OrderServiceHost string
+	OrderServicePort int
+
+	// GrpcClientTimeout bounds every outgoing call to a downstream service,
+	// retries included
+	GrpcClientTimeout time.Duration
+	// GrpcClientMaxAttempts is how many times an outgoing call is tried
+	// when the downstream service is unavailable
+	GrpcClientMaxAttempts int
+
+	// EventPublisher is where catalog events from the outbox go: memory,
+	// stdout or file
+	EventPublisher string
+	// EventFile is the file events are appended to by the file publisher
+	EventFile          string
+	OutboxPollInterval time.Duration
+	OutboxBatchSize    int
+
+	// OutboxPublishTimeout bounds publishing a batch of events, which are
+	// claimed by the relay publishing them for as long
+	OutboxPublishTimeout time.Duration
+	// OutboxRetention is how long published events are kept for change
+	// feeds to replay, zero keeps them forever
+	OutboxRetention time.Duration
+
+	// IdempotencyKeyTTL is how long responses of requests with an
+	// idempotency key are replayed
+	IdempotencyKeyTTL time.Duration
+	// IdempotencyLease is how long a request holds its idempotency key
+	// before a retry can take it over, in case the request never completes.
+	// It has to be longer than requests take.
+	IdempotencyLease time.Duration
+
+	// AllowClientIDs keeps ids sent to create methods instead of generating
+	// new ones, for migrating entities with their existing ids
+	AllowClientIDs bool
+
+	// PriceCurrency is the ISO 4217 code of the currency book prices are in
+	PriceCurrency string
+
+	// HealthCheckInterval is how often the database and downstream
+	// connections are checked for the grpc health service
+	HealthCheckInterval time.Duration
+
+	// PostgresSSLMode is the sslmode of lib/pq, PostgresSSLRootCert the CA
+	// checked by verify-ca and verify-full
+	PostgresSSLMode     string
+	PostgresSSLRootCert string
+
+	// LogEncoding is either json or console
+	LogEncoding string
+	// LogSamplingInitial debug entries with the same message are logged
+	// every second, then every LogSamplingThereafter-th one. Zero logs all.
+	LogSamplingInitial    int
+	LogSamplingThereafter int
+
+	// MetricsPort is where prometheus metrics are served over http, on
+	// /metrics
+	MetricsPort string
+	// AdminPort is where the log level is served over http, on /log/level.
+	// Anyone reaching it can change the level, so it listens on localhost
+	// by default. Empty disables it.
+	AdminPort string
+
+	// TracingExporter is where spans go: none, stdout or otlp
+	TracingExporter string
+	// TracingEndpoint is the host:port of the OTLP collector
+	TracingEndpoint string
+	TracingInsecure bool
+	// TracingSampleRatio is the share of traces started here which are
+	// sampled
+	TracingSampleRatio float64
+
+	// AuthEnabled requires a bearer token signed with one of the Auth keys
+	// on every call except health checks
+	AuthEnabled          bool
+	AuthHMACSecret       string
+	AuthRSAPublicKeyFile string
+	AuthJWKSFile         string
+	AuthIssuer           string
+	AuthAudience         string
+	AuthRolesClaim       string
+
+	// TLSCertFile and TLSKeyFile turn on TLS for the grpc server, the
+	// certificate is reloaded when the files change. TLSClientCAFile turns
+	// on mutual TLS, requiring client certificates signed by its CAs.
+	TLSCertFile     string
+	TLSKeyFile      string
+	TLSClientCAFile string
+
+	// GrpcClientTLS dials downstream services over TLS, trusting the system
+	// roots or GrpcClientCAFile. The client certificate is presented to
+	// services requiring mutual TLS.
+	GrpcClientTLS        bool
+	GrpcClientCAFile     string
+	GrpcClientCertFile   string
+	GrpcClientKeyFile    string
+	GrpcClientServerName string
+
+	// RateLimitRPS and RateLimitBurst are the token bucket every client gets
+	// on every method, zero RPS turns rate limiting off. RateLimitMethods
+	// overrides it for some methods as method=rps:burst pairs, e.g.
+	// /catalog.CatalogService/ListBook=5:10. MaxInFlight caps the requests
+	// handled at once, zero means no cap.
+	RateLimitRPS     float64
+	RateLimitBurst   int
+	RateLimitMethods string
+	MaxInFlight      int
+
+	// HTTPPort is where the HTTP/JSON gateway is served, it's turned off
+	// when empty. The gateway dials the grpc server over TLS when it's on,
+	// trusting HTTPGatewayCAFile and presenting its certificate if the
+	// server requires mutual TLS.
+	HTTPPort              string
+	HTTPGatewayCAFile     string
+	HTTPGatewayCertFile   string
+	HTTPGatewayKeyFile    string
+	HTTPGatewayServerName string
+
+	// ShutdownTimeout is how long in-flight requests are waited for on
+	// SIGTERM before they are cancelled
+	ShutdownTimeout time.Duration
 }
 
 // Load loads environment vars and inflates Config
@@ -31,11 +160,79 @@ func Load() Config {
 	c.PostgresDatabase = cast.ToString(getOrReturnDefault("POSTGRES_DATABASE", "catalog"))
 	c.PostgresUser = cast.ToString(getOrReturnDefault("POSTGRES_USER", "abdulloh"))
 	c.PostgresPassword = cast.ToString(getOrReturnDefault("POSTGRES_PASSWORD", "abdulloh"))
+	c.PostgresSSLMode = cast.ToString(getOrReturnDefault("POSTGRES_SSLMODE", "disable"))
+	c.PostgresSSLRootCert = cast.ToString(getOrReturnDefault("POSTGRES_SSLROOTCERT", ""))
 
 	c.LogLevel = cast.ToString(getOrReturnDefault("LOG_LEVEL", "debug"))
+	c.LogEncoding = cast.ToString(getOrReturnDefault("LOG_ENCODING", "json"))
+	c.LogSamplingInitial = cast.ToInt(getOrReturnDefault("LOG_SAMPLING_INITIAL", 0))
+	c.LogSamplingThereafter = cast.ToInt(getOrReturnDefault("LOG_SAMPLING_THEREAFTER", 100))
 
 	c.RPCPort = cast.ToString(getOrReturnDefault("RPC_PORT", ":9000"))
 
+	c.ReviewServiceHost = cast.ToString(getOrReturnDefault("REVIEW_SERVICE_HOST", "localhost"))
+	c.ReviewServicePort = cast.ToInt(getOrReturnDefault("REVIEW_SERVICE_PORT", 9002))
+	c.ReviewServiceFake = cast.ToBool(getOrReturnDefault("REVIEW_SERVICE_FAKE", false))
+	c.ReviewCacheTTL = cast.ToDuration(getOrReturnDefault("REVIEW_CACHE_TTL", "1m"))
+
+	c.OrderServiceHost = cast.ToString(getOrReturnDefault("ORDER_SERVICE_HOST", "localhost"))
+	c.OrderServicePort = cast.ToInt(getOrReturnDefault("ORDER_SERVICE_PORT", 9001))
+
+	c.GrpcClientTimeout = cast.ToDuration(getOrReturnDefault("GRPC_CLIENT_TIMEOUT", "5s"))
+	c.GrpcClientMaxAttempts = cast.ToInt(getOrReturnDefault("GRPC_CLIENT_MAX_ATTEMPTS", 3))
+
+	c.EventPublisher = cast.ToString(getOrReturnDefault("EVENT_PUBLISHER", "stdout"))
+	c.EventFile = cast.ToString(getOrReturnDefault("EVENT_FILE", "catalog-events.jsonl"))
+	c.OutboxPollInterval = cast.ToDuration(getOrReturnDefault("OUTBOX_POLL_INTERVAL", "1s"))
+	c.OutboxBatchSize = cast.ToInt(getOrReturnDefault("OUTBOX_BATCH_SIZE", 100))
+	c.OutboxPublishTimeout = cast.ToDuration(getOrReturnDefault("OUTBOX_PUBLISH_TIMEOUT", "30s"))
+	c.OutboxRetention = cast.ToDuration(getOrReturnDefault("OUTBOX_RETENTION", "168h"))
+
+	c.IdempotencyKeyTTL = cast.ToDuration(getOrReturnDefault("IDEMPOTENCY_KEY_TTL", "24h"))
+	c.IdempotencyLease = cast.ToDuration(getOrReturnDefault("IDEMPOTENCY_LEASE", "1m"))
+
+	c.AllowClientIDs = cast.ToBool(getOrReturnDefault("ALLOW_CLIENT_IDS", false))
+
+	c.PriceCurrency = cast.ToString(getOrReturnDefault("PRICE_CURRENCY", "USD"))
+
+	c.HealthCheckInterval = cast.ToDuration(getOrReturnDefault("HEALTH_CHECK_INTERVAL", "5s"))
+	c.MetricsPort = cast.ToString(getOrReturnDefault("METRICS_PORT", ":9090"))
+	c.AdminPort = cast.ToString(getOrReturnDefault("ADMIN_PORT", "localhost:9091"))
+	c.TracingExporter = cast.ToString(getOrReturnDefault("TRACING_EXPORTER", "none"))
+	c.TracingEndpoint = cast.ToString(getOrReturnDefault("TRACING_ENDPOINT", "localhost:4317"))
+	c.TracingInsecure = cast.ToBool(getOrReturnDefault("TRACING_INSECURE", true))
+	c.TracingSampleRatio = cast.ToFloat64(getOrReturnDefault("TRACING_SAMPLE_RATIO", 1.0))
+	c.AuthEnabled = cast.ToBool(getOrReturnDefault("AUTH_ENABLED", false))
+	c.AuthHMACSecret = cast.ToString(getOrReturnDefault("AUTH_HMAC_SECRET", ""))
+	c.AuthRSAPublicKeyFile = cast.ToString(getOrReturnDefault("AUTH_RSA_PUBLIC_KEY_FILE", ""))
+	c.AuthJWKSFile = cast.ToString(getOrReturnDefault("AUTH_JWKS_FILE", ""))
+	c.AuthIssuer = cast.ToString(getOrReturnDefault("AUTH_ISSUER", ""))
+	c.AuthAudience = cast.ToString(getOrReturnDefault("AUTH_AUDIENCE", ""))
+	c.AuthRolesClaim = cast.ToString(getOrReturnDefault("AUTH_ROLES_CLAIM", "roles"))
+
+	c.TLSCertFile = cast.ToString(getOrReturnDefault("TLS_CERT_FILE", ""))
+	c.TLSKeyFile = cast.ToString(getOrReturnDefault("TLS_KEY_FILE", ""))
+	c.TLSClientCAFile = cast.ToString(getOrReturnDefault("TLS_CLIENT_CA_FILE", ""))
+
+	c.GrpcClientTLS = cast.ToBool(getOrReturnDefault("GRPC_CLIENT_TLS", false))
+	c.GrpcClientCAFile = cast.ToString(getOrReturnDefault("GRPC_CLIENT_CA_FILE", ""))
+	c.GrpcClientCertFile = cast.ToString(getOrReturnDefault("GRPC_CLIENT_CERT_FILE", ""))
+	c.GrpcClientKeyFile = cast.ToString(getOrReturnDefault("GRPC_CLIENT_KEY_FILE", ""))
+	c.GrpcClientServerName = cast.ToString(getOrReturnDefault("GRPC_CLIENT_SERVER_NAME", ""))
+
+	c.RateLimitRPS = cast.ToFloat64(getOrReturnDefault("RATE_LIMIT_RPS", 50))
+	c.RateLimitBurst = cast.ToInt(getOrReturnDefault("RATE_LIMIT_BURST", 100))
+	c.RateLimitMethods = cast.ToString(getOrReturnDefault("RATE_LIMIT_METHODS", ""))
+	c.MaxInFlight = cast.ToInt(getOrReturnDefault("MAX_IN_FLIGHT", 200))
+
+	c.HTTPPort = cast.ToString(getOrReturnDefault("HTTP_PORT", ":8080"))
+	c.HTTPGatewayCAFile = cast.ToString(getOrReturnDefault("HTTP_GATEWAY_CA_FILE", ""))
+	c.HTTPGatewayCertFile = cast.ToString(getOrReturnDefault("HTTP_GATEWAY_CERT_FILE", ""))
+	c.HTTPGatewayKeyFile = cast.ToString(getOrReturnDefault("HTTP_GATEWAY_KEY_FILE", ""))
+	c.HTTPGatewayServerName = cast.ToString(getOrReturnDefault("HTTP_GATEWAY_SERVER_NAME", ""))
+
+	c.ShutdownTimeout = cast.ToDuration(getOrReturnDefault("SHUTDOWN_TIMEOUT", "30s"))
+
 	return c
 }
 
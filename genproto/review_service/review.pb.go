@@ -0,0 +1,855 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: review_service/review.proto
+
+package review
+
+import (
+	context "context"
+	encoding_binary "encoding/binary"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type BookIdsReq struct {
+	BookIds              []string `protobuf:"bytes,1,rep,name=BookIds,proto3" json:"BookIds"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BookIdsReq) Reset()         { *m = BookIdsReq{} }
+func (m *BookIdsReq) String() string { return proto.CompactTextString(m) }
+func (*BookIdsReq) ProtoMessage()    {}
+func (*BookIdsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5fcd53280b82858, []int{0}
+}
+func (m *BookIdsReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BookIdsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BookIdsReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BookIdsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BookIdsReq.Merge(m, src)
+}
+func (m *BookIdsReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *BookIdsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_BookIdsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BookIdsReq proto.InternalMessageInfo
+
+func (m *BookIdsReq) GetBookIds() []string {
+	if m != nil {
+		return m.BookIds
+	}
+	return nil
+}
+
+type BookRating struct {
+	BookId               string   `protobuf:"bytes,1,opt,name=BookId,proto3" json:"BookId"`
+	AverageRating        float32  `protobuf:"fixed32,2,opt,name=AverageRating,proto3" json:"AverageRating"`
+	ReviewCount          int64    `protobuf:"varint,3,opt,name=ReviewCount,proto3" json:"ReviewCount"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BookRating) Reset()         { *m = BookRating{} }
+func (m *BookRating) String() string { return proto.CompactTextString(m) }
+func (*BookRating) ProtoMessage()    {}
+func (*BookRating) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5fcd53280b82858, []int{1}
+}
+func (m *BookRating) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BookRating) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BookRating.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BookRating) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BookRating.Merge(m, src)
+}
+func (m *BookRating) XXX_Size() int {
+	return m.Size()
+}
+func (m *BookRating) XXX_DiscardUnknown() {
+	xxx_messageInfo_BookRating.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BookRating proto.InternalMessageInfo
+
+func (m *BookRating) GetBookId() string {
+	if m != nil {
+		return m.BookId
+	}
+	return ""
+}
+
+func (m *BookRating) GetAverageRating() float32 {
+	if m != nil {
+		return m.AverageRating
+	}
+	return 0
+}
+
+func (m *BookRating) GetReviewCount() int64 {
+	if m != nil {
+		return m.ReviewCount
+	}
+	return 0
+}
+
+type BookRatingsResp struct {
+	Ratings              []*BookRating `protobuf:"bytes,1,rep,name=Ratings,proto3" json:"Ratings"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *BookRatingsResp) Reset()         { *m = BookRatingsResp{} }
+func (m *BookRatingsResp) String() string { return proto.CompactTextString(m) }
+func (*BookRatingsResp) ProtoMessage()    {}
+func (*BookRatingsResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5fcd53280b82858, []int{2}
+}
+func (m *BookRatingsResp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BookRatingsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BookRatingsResp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BookRatingsResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BookRatingsResp.Merge(m, src)
+}
+func (m *BookRatingsResp) XXX_Size() int {
+	return m.Size()
+}
+func (m *BookRatingsResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_BookRatingsResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BookRatingsResp proto.InternalMessageInfo
+
+func (m *BookRatingsResp) GetRatings() []*BookRating {
+	if m != nil {
+		return m.Ratings
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BookIdsReq)(nil), "review.BookIdsReq")
+	proto.RegisterType((*BookRating)(nil), "review.BookRating")
+	proto.RegisterType((*BookRatingsResp)(nil), "review.BookRatingsResp")
+}
+
+func init() { proto.RegisterFile("review_service/review.proto", fileDescriptor_f5fcd53280b82858) }
+
+var fileDescriptor_f5fcd53280b82858 = []byte{
+	// 231 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2e, 0x4a, 0x2d, 0xcb,
+	0x4c, 0x2d, 0x8f, 0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0xd5, 0x87, 0x70, 0xf5, 0x0a, 0x8a,
+	0xf2, 0x4b, 0xf2, 0x85, 0xd8, 0x20, 0x3c, 0x25, 0x35, 0x2e, 0x2e, 0xa7, 0xfc, 0xfc, 0x6c, 0xcf,
+	0x94, 0xe2, 0xa0, 0xd4, 0x42, 0x21, 0x09, 0x2e, 0x76, 0x28, 0x4f, 0x82, 0x51, 0x81, 0x59, 0x83,
+	0x33, 0x08, 0xc6, 0x55, 0xca, 0x81, 0xa8, 0x0b, 0x4a, 0x2c, 0xc9, 0xcc, 0x4b, 0x17, 0x12, 0xe3,
+	0x62, 0x83, 0x48, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06, 0x41, 0x79, 0x42, 0x2a, 0x5c, 0xbc,
+	0x8e, 0x65, 0xa9, 0x45, 0x89, 0xe9, 0xa9, 0x10, 0x85, 0x12, 0x4c, 0x0a, 0x8c, 0x1a, 0x4c, 0x41,
+	0xa8, 0x82, 0x42, 0x0a, 0x5c, 0xdc, 0x41, 0x60, 0xdb, 0x9d, 0xf3, 0x4b, 0xf3, 0x4a, 0x24, 0x98,
+	0x15, 0x18, 0x35, 0x98, 0x83, 0x90, 0x85, 0x94, 0xec, 0xb9, 0xf8, 0x11, 0xb6, 0x15, 0x07, 0xa5,
+	0x16, 0x17, 0x08, 0xe9, 0x70, 0xb1, 0x43, 0xb9, 0x60, 0xa7, 0x71, 0x1b, 0x09, 0xe9, 0x41, 0x3d,
+	0x84, 0x50, 0x19, 0x04, 0x53, 0x62, 0xe4, 0xc7, 0xc5, 0x0b, 0x31, 0x2f, 0x18, 0xe2, 0x79, 0x21,
+	0x5b, 0x2e, 0x3e, 0xf7, 0xd4, 0x12, 0x24, 0x43, 0x85, 0x50, 0xf4, 0x43, 0xfc, 0x2f, 0x25, 0x8e,
+	0x69, 0x26, 0xd8, 0x76, 0x27, 0x81, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0,
+	0x48, 0x8e, 0x71, 0xc6, 0x63, 0x39, 0x86, 0x24, 0x36, 0x70, 0x38, 0x1a, 0x03, 0x06, 0x00, 0xd1,
+	0x63, 0xb6, 0x6c, 0x66, 0x01, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ReviewServiceClient is the client API for ReviewService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ReviewServiceClient interface {
+	GetBookRatings(ctx context.Context, in *BookIdsReq, opts ...grpc.CallOption) (*BookRatingsResp, error)
+}
+
+type reviewServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewReviewServiceClient(cc *grpc.ClientConn) ReviewServiceClient {
+	return &reviewServiceClient{cc}
+}
+
+func (c *reviewServiceClient) GetBookRatings(ctx context.Context, in *BookIdsReq, opts ...grpc.CallOption) (*BookRatingsResp, error) {
+	out := new(BookRatingsResp)
+	err := c.cc.Invoke(ctx, "/review.ReviewService/GetBookRatings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReviewServiceServer is the server API for ReviewService service.
+type ReviewServiceServer interface {
+	GetBookRatings(context.Context, *BookIdsReq) (*BookRatingsResp, error)
+}
+
+// UnimplementedReviewServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedReviewServiceServer struct {
+}
+
+func (*UnimplementedReviewServiceServer) GetBookRatings(ctx context.Context, req *BookIdsReq) (*BookRatingsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBookRatings not implemented")
+}
+
+func RegisterReviewServiceServer(s *grpc.Server, srv ReviewServiceServer) {
+	s.RegisterService(&_ReviewService_serviceDesc, srv)
+}
+
+func _ReviewService_GetBookRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BookIdsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewServiceServer).GetBookRatings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/review.ReviewService/GetBookRatings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewServiceServer).GetBookRatings(ctx, req.(*BookIdsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ReviewService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "review.ReviewService",
+	HandlerType: (*ReviewServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetBookRatings",
+			Handler:    _ReviewService_GetBookRatings_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "review_service/review.proto",
+}
+
+func (m *BookIdsReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BookIdsReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BookIdsReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BookIds) > 0 {
+		for iNdEx := len(m.BookIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BookIds[iNdEx])
+			copy(dAtA[i:], m.BookIds[iNdEx])
+			i = encodeVarintReview(dAtA, i, uint64(len(m.BookIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BookRating) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BookRating) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BookRating) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ReviewCount != 0 {
+		i = encodeVarintReview(dAtA, i, uint64(m.ReviewCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AverageRating != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.AverageRating))))
+		i--
+		dAtA[i] = 0x15
+	}
+	if len(m.BookId) > 0 {
+		i -= len(m.BookId)
+		copy(dAtA[i:], m.BookId)
+		i = encodeVarintReview(dAtA, i, uint64(len(m.BookId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BookRatingsResp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BookRatingsResp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BookRatingsResp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Ratings) > 0 {
+		for iNdEx := len(m.Ratings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ratings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReview(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReview(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReview(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BookIdsReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BookIds) > 0 {
+		for _, s := range m.BookIds {
+			l = len(s)
+			n += 1 + l + sovReview(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BookRating) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BookId)
+	if l > 0 {
+		n += 1 + l + sovReview(uint64(l))
+	}
+	if m.AverageRating != 0 {
+		n += 5
+	}
+	if m.ReviewCount != 0 {
+		n += 1 + sovReview(uint64(m.ReviewCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BookRatingsResp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ratings) > 0 {
+		for _, e := range m.Ratings {
+			l = e.Size()
+			n += 1 + l + sovReview(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovReview(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReview(x uint64) (n int) {
+	return sovReview(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BookIdsReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReview
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BookIdsReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BookIdsReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BookIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReview
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReview
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BookIds = append(m.BookIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReview(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReview
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BookRating) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReview
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BookRating: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BookRating: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BookId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReview
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReview
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BookId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 5 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageRating", wireType)
+			}
+			var v uint32
+			if (iNdEx + 4) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint32(encoding_binary.LittleEndian.Uint32(dAtA[iNdEx:]))
+			iNdEx += 4
+			m.AverageRating = float32(math.Float32frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReviewCount", wireType)
+			}
+			m.ReviewCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReviewCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReview(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReview
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BookRatingsResp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReview
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BookRatingsResp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BookRatingsResp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReview
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReview
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReview
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ratings = append(m.Ratings, &BookRating{})
+			if err := m.Ratings[len(m.Ratings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReview(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReview
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReview(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReview
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReview
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReview
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReview
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReview
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReview
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReview        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReview          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReview = fmt.Errorf("proto: unexpected end of group")
+)
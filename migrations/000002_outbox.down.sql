@@ -0,0 +1,3 @@
+begin;
+drop table if exists outbox;
+commit;
\ No newline at end of file
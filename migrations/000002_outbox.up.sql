@@ -0,0 +1,13 @@
+begin;
+create table if not exists outbox(
+    id bigserial primary key,
+    aggregate_type varchar(32) not null,
+    aggregate_id uuid not null,
+    event_type varchar(64) not null,
+    payload jsonb not null,
+    created_at timestamp default current_timestamp,
+    published_at timestamp default null
+);
+
+create index if not exists outbox_unpublished_idx on outbox(id) where published_at is null;
+commit;
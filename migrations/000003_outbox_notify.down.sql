@@ -0,0 +1,5 @@
+begin;
+drop index if exists outbox_aggregate_type_idx;
+drop trigger if exists outbox_notify on outbox;
+drop function if exists notify_catalog_event();
+commit;
@@ -0,0 +1,13 @@
+begin;
+create or replace function notify_catalog_event() returns trigger as $$
+begin
+    perform pg_notify('catalog_events', new.id::text);
+    return new;
+end;
+$$ language plpgsql;
+
+create trigger outbox_notify after insert on outbox
+    for each row execute procedure notify_catalog_event();
+
+create index if not exists outbox_aggregate_type_idx on outbox(aggregate_type, id);
+commit;
@@ -0,0 +1,3 @@
+begin;
+drop table if exists audit_events;
+commit;
\ No newline at end of file
@@ -0,0 +1,14 @@
+begin;
+create table if not exists audit_events(
+    id bigserial primary key,
+    actor varchar(128) not null,
+    entity_type varchar(32) not null,
+    entity_id uuid not null,
+    operation varchar(32) not null,
+    changes jsonb not null default '[]',
+    created_at timestamp default current_timestamp
+);
+
+create index if not exists audit_events_entity_idx on audit_events(entity_type, entity_id, created_at);
+create index if not exists audit_events_created_at_idx on audit_events(created_at);
+commit;
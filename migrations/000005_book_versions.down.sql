@@ -0,0 +1,3 @@
+begin;
+drop table if exists book_versions;
+commit;
\ No newline at end of file
@@ -0,0 +1,22 @@
+begin;
+create table if not exists book_versions(
+    book_id uuid references books(book_id),
+    version integer not null,
+    name varchar(64),
+    author_id uuid,
+    price decimal default null,
+    category_ids uuid[] not null default '{}',
+    deleted boolean not null default false,
+    created_at timestamp default current_timestamp,
+    primary key (book_id, version)
+);
+
+create index if not exists book_versions_created_at_idx on book_versions(book_id, created_at);
+
+insert into book_versions(book_id, version, name, author_id, price, category_ids, deleted, created_at)
+select b.book_id, 1, b.name, b.author_id, b.price,
+       array(select bc.category_id from book_categories bc where bc.book_id = b.book_id),
+       b.deleted_at is not null, coalesce(b.updated_at, current_timestamp)
+from books b
+on conflict do nothing;
+commit;
@@ -0,0 +1,5 @@
+begin;
+alter table books drop column if exists version;
+alter table authors drop column if exists version;
+alter table categories drop column if exists version;
+commit;
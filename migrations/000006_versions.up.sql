@@ -0,0 +1,9 @@
+begin;
+alter table categories add column if not exists version integer not null default 1;
+alter table authors add column if not exists version integer not null default 1;
+alter table books add column if not exists version integer not null default 1;
+
+update books b set version = v.version
+from (select book_id, max(version) as version from book_versions group by book_id) v
+where v.book_id = b.book_id;
+commit;
@@ -0,0 +1,10 @@
+begin;
+alter table book_versions drop column if exists external_id;
+alter table book_versions drop column if exists isbn;
+
+drop index if exists books_external_id_idx;
+drop index if exists books_isbn_idx;
+
+alter table books drop column if exists external_id;
+alter table books drop column if exists isbn;
+commit;
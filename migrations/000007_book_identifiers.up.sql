@@ -0,0 +1,10 @@
+begin;
+alter table books add column if not exists isbn varchar(13) default null;
+alter table books add column if not exists external_id varchar(64) default null;
+
+create unique index if not exists books_isbn_idx on books(isbn) where deleted_at is null;
+create unique index if not exists books_external_id_idx on books(external_id) where deleted_at is null;
+
+alter table book_versions add column if not exists isbn varchar(13) default null;
+alter table book_versions add column if not exists external_id varchar(64) default null;
+commit;
@@ -0,0 +1,3 @@
+begin;
+drop table if exists idempotency_keys;
+commit;
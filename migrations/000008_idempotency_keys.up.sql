@@ -0,0 +1,10 @@
+begin;
+create table if not exists idempotency_keys(
+    key varchar(128) not null,
+    method varchar(128) not null,
+    request_hash char(64) not null,
+    response bytea default null,
+    created_at timestamp default current_timestamp,
+    primary key (key, method)
+);
+commit;
@@ -0,0 +1,5 @@
+begin;
+drop index if exists outbox_published_at_idx;
+
+alter table outbox drop column if exists claimed_until;
+commit;
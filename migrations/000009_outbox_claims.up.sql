@@ -0,0 +1,5 @@
+begin;
+alter table outbox add column if not exists claimed_until timestamp default null;
+
+create index if not exists outbox_published_at_idx on outbox(published_at) where published_at is not null;
+commit;
@@ -0,0 +1,11 @@
+begin;
+drop index if exists outbox_aggregate_type_sequence_idx;
+create index if not exists outbox_aggregate_type_idx on outbox(aggregate_type, id);
+
+drop index if exists outbox_unsequenced_idx;
+drop index if exists outbox_sequence_idx;
+
+drop table if exists outbox_sequence;
+
+alter table outbox drop column if exists sequence;
+commit;
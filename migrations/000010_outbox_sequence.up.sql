@@ -0,0 +1,21 @@
+begin;
+alter table outbox add column if not exists sequence bigint default null;
+
+-- events written so far were serialized by an advisory lock, so their ids
+-- are in commit order already
+update outbox set sequence = id where sequence is null;
+
+-- last is the sequence given to the latest event, purged_through the
+-- greatest sequence of a purged event
+create table if not exists outbox_sequence(
+    last bigint not null,
+    purged_through bigint not null default 0
+);
+insert into outbox_sequence(last) select coalesce(max(id), 0) from outbox;
+
+create unique index if not exists outbox_sequence_idx on outbox(sequence);
+create index if not exists outbox_unsequenced_idx on outbox(id) where sequence is null;
+
+drop index if exists outbox_aggregate_type_idx;
+create index if not exists outbox_aggregate_type_sequence_idx on outbox(aggregate_type, sequence);
+commit;
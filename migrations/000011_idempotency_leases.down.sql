@@ -0,0 +1,5 @@
+begin;
+drop index if exists idempotency_keys_created_at_idx;
+
+alter table idempotency_keys drop column if exists locked_until;
+commit;
@@ -0,0 +1,8 @@
+begin;
+-- requests in progress hold their key until locked_until, after which a
+-- retry takes it over
+alter table idempotency_keys add column if not exists locked_until timestamp default null;
+update idempotency_keys set locked_until = created_at where response is null;
+
+create index if not exists idempotency_keys_created_at_idx on idempotency_keys(created_at);
+commit;
@@ -0,0 +1,8 @@
+begin;
+delete from idempotency_keys where caller <> '';
+
+alter table idempotency_keys drop constraint if exists idempotency_keys_pkey;
+alter table idempotency_keys add primary key (key, method);
+
+alter table idempotency_keys drop column if exists caller;
+commit;
@@ -0,0 +1,8 @@
+begin;
+-- keys are scoped to the caller which sent them, so a caller can't replay
+-- the response of another one. Anonymous callers share the empty caller.
+alter table idempotency_keys add column if not exists caller text not null default '';
+
+alter table idempotency_keys drop constraint if exists idempotency_keys_pkey;
+alter table idempotency_keys add primary key (key, method, caller);
+commit;
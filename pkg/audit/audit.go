@@ -0,0 +1,127 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	"github.com/abdullohsattorov/catalog-service/pkg/auth"
+)
+
+// ActorHeader is the request metadata naming who makes the change
+const ActorHeader = "x-actor"
+
+const anonymousActor = "anonymous"
+
+// maxActorLength is the length of the actor column of audit events
+const maxActorLength = 128
+
+// Audited operations
+const (
+	OpCreate = "create"
+	OpUpdate = "update"
+	OpDelete = "delete"
+	// OpRestore is an upsert bringing back a deleted entity
+	OpRestore = "restore"
+)
+
+// notAudited are fields which change on every write or aren't stored by
+// the catalog, so they don't belong to the diff
+var notAudited = map[string]bool{
+	"CreatedAt":      true,
+	"UpdatedAt":      true,
+	"Categories":     true,
+	"ParentCategory": true,
+	"AverageRating":  true,
+	"ReviewCount":    true,
+	"Version":        true,
+}
+
+// Actor returns the subject of the caller's token, or the actor header when
+// requests aren't authenticated, cut to the length the audit log stores
+func Actor(ctx context.Context) string {
+	if principal, ok := auth.FromContext(ctx); ok {
+		return truncate(principal.Subject, maxActorLength)
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return anonymousActor
+	}
+
+	if actor := md.Get(ActorHeader); len(actor) > 0 && actor[0] != "" {
+		return truncate(actor[0], maxActorLength)
+	}
+
+	return anonymousActor
+}
+
+// truncate cuts s to n characters, which is how varchar columns count
+func truncate(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+
+	return string(runes[:n])
+}
+
+// Diff compares exported fields of two structs of the same type, or of a
+// struct and nil, and returns the ones which differ
+func Diff(before, after interface{}) []*pb.FieldChange {
+	var b, a reflect.Value
+	if before != nil {
+		b = reflect.Indirect(reflect.ValueOf(before))
+	}
+	if after != nil {
+		a = reflect.Indirect(reflect.ValueOf(after))
+	}
+
+	var t reflect.Type
+	switch {
+	case b.IsValid():
+		t = b.Type()
+	case a.IsValid():
+		t = a.Type()
+	default:
+		return nil
+	}
+
+	var changes []*pb.FieldChange
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" || strings.HasPrefix(field.Name, "XXX_") || notAudited[field.Name] {
+			continue
+		}
+
+		var oldValue, newValue string
+		if b.IsValid() {
+			oldValue = formatField(b.Field(i))
+		}
+		if a.IsValid() {
+			newValue = formatField(a.Field(i))
+		}
+
+		if oldValue != newValue {
+			changes = append(changes, &pb.FieldChange{
+				Field:  field.Name,
+				Before: oldValue,
+				After:  newValue,
+			})
+		}
+	}
+
+	return changes
+}
+
+func formatField(v reflect.Value) string {
+	if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.String {
+		return strings.Join(v.Interface().([]string), ",")
+	}
+
+	return fmt.Sprint(v.Interface())
+}
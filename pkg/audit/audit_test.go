@@ -0,0 +1,55 @@
+package audit
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/abdullohsattorov/catalog-service/pkg/auth"
+)
+
+func TestActor(t *testing.T) {
+	long := strings.Repeat("ж", maxActorLength+10)
+
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		actor string
+	}{
+		{
+			name:  "no metadata",
+			ctx:   context.Background(),
+			actor: anonymousActor,
+		},
+		{
+			name:  "actor header",
+			ctx:   metadata.NewIncomingContext(context.Background(), metadata.Pairs(ActorHeader, "importer")),
+			actor: "importer",
+		},
+		{
+			name:  "subject of the token wins over the header",
+			ctx:   auth.NewContext(metadata.NewIncomingContext(context.Background(), metadata.Pairs(ActorHeader, "importer")), auth.Principal{Subject: "user-1"}),
+			actor: "user-1",
+		},
+		{
+			name:  "long header is cut to the column length",
+			ctx:   metadata.NewIncomingContext(context.Background(), metadata.Pairs(ActorHeader, long)),
+			actor: strings.Repeat("ж", maxActorLength),
+		},
+		{
+			name:  "long subject is cut to the column length",
+			ctx:   auth.NewContext(context.Background(), auth.Principal{Subject: long}),
+			actor: strings.Repeat("ж", maxActorLength),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actor := Actor(tt.ctx); actor != tt.actor {
+				t.Fatalf("got actor %q, want %q", actor, tt.actor)
+			}
+		})
+	}
+}
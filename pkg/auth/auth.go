@@ -0,0 +1,153 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang-jwt/jwt"
+)
+
+// Role grants access to a set of methods. Every role has the permissions of
+// the roles below it.
+type Role int
+
+// Roles from the least to the most privileged. Public methods need no token.
+const (
+	Public Role = iota
+	Reader
+	Editor
+	Admin
+)
+
+var roleNames = map[string]Role{
+	"reader": Reader,
+	"editor": Editor,
+	"admin":  Admin,
+}
+
+func (r Role) String() string {
+	for name, role := range roleNames {
+		if role == r {
+			return name
+		}
+	}
+
+	return "public"
+}
+
+// Principal is the caller a valid token was issued to
+type Principal struct {
+	Subject string
+	Roles   []string
+}
+
+// Role returns the most privileged role of the principal
+func (p Principal) Role() Role {
+	highest := Public
+	for _, name := range p.Roles {
+		if role, ok := roleNames[strings.ToLower(name)]; ok && role > highest {
+			highest = role
+		}
+	}
+
+	return highest
+}
+
+type principalKey struct{}
+
+// NewContext returns a copy of ctx carrying p
+func NewContext(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// FromContext returns the principal of an authenticated request
+func FromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
+
+// Config of the authenticator. Tokens signed with HMAC are checked with the
+// secret, ones signed with RSA with the public key or the key of the JWKS
+// file named by their kid header.
+type Config struct {
+	HMACSecret       string
+	RSAPublicKeyFile string
+	JWKSFile         string
+	// Issuer and Audience are checked when they are set
+	Issuer   string
+	Audience string
+	// RolesClaim names the claim holding the roles, either as a list or as
+	// a space separated string
+	RolesClaim string
+}
+
+// Authenticator validates bearer tokens
+type Authenticator struct {
+	cfg  Config
+	keys keySet
+}
+
+// New loads the keys configured by cfg, at least one is required
+func New(cfg Config) (*Authenticator, error) {
+	keys, err := loadKeys(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.RolesClaim == "" {
+		cfg.RolesClaim = "roles"
+	}
+
+	return &Authenticator{cfg: cfg, keys: keys}, nil
+}
+
+// Authenticate validates the token and returns who it was issued to
+func (a *Authenticator) Authenticate(token string) (Principal, error) {
+	parser := jwt.Parser{ValidMethods: a.keys.methods()}
+
+	claims := jwt.MapClaims{}
+	if _, err := parser.ParseWithClaims(token, claims, a.keys.key); err != nil {
+		return Principal{}, err
+	}
+
+	if a.cfg.Issuer != "" && !claims.VerifyIssuer(a.cfg.Issuer, true) {
+		return Principal{}, errors.New("token has a different issuer")
+	}
+	if a.cfg.Audience != "" && !claims.VerifyAudience(a.cfg.Audience, true) {
+		return Principal{}, errors.New("token is meant for a different audience")
+	}
+
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return Principal{}, errors.New("token has no subject")
+	}
+
+	roles, err := rolesOf(claims[a.cfg.RolesClaim])
+	if err != nil {
+		return Principal{}, err
+	}
+
+	return Principal{Subject: subject, Roles: roles}, nil
+}
+
+func rolesOf(claim interface{}) ([]string, error) {
+	switch v := claim.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return strings.Fields(v), nil
+	case []interface{}:
+		roles := make([]string, 0, len(v))
+		for _, role := range v {
+			name, ok := role.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid role %v", role)
+			}
+			roles = append(roles, name)
+		}
+		return roles, nil
+	default:
+		return nil, fmt.Errorf("invalid roles claim %v", claim)
+	}
+}
@@ -0,0 +1,320 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testSecret   = "secret"
+	testIssuer   = "https://issuer.example"
+	testAudience = "catalog"
+	testKid      = "key-1"
+)
+
+// newTestKey returns a key and the path of a JWKS file holding its public
+// key under testKid
+func newTestKey(t *testing.T) (*rsa.PrivateKey, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	set, err := json.Marshal(map[string][]jwk{"keys": {{
+		Kty: "RSA",
+		Kid: testKid,
+		Use: "sig",
+		N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err = ioutil.WriteFile(path, set, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return key, path
+}
+
+func mustECKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return key
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":   "user-1",
+		"iss":   testIssuer,
+		"aud":   testAudience,
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"roles": []string{"reader", "editor"},
+	}
+}
+
+func withClaim(name string, value interface{}) jwt.MapClaims {
+	claims := validClaims()
+	if value == nil {
+		delete(claims, name)
+	} else {
+		claims[name] = value
+	}
+
+	return claims
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, kid string, key interface{}, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return signed
+}
+
+func TestAuthenticate(t *testing.T) {
+	key, jwksFile := newTestKey(t)
+	otherKey, _ := newTestKey(t)
+
+	a, err := New(Config{
+		HMACSecret: testSecret,
+		JWKSFile:   jwksFile,
+		Issuer:     testIssuer,
+		Audience:   testAudience,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		token     string
+		principal Principal
+		invalid   bool
+	}{
+		{
+			name:      "signed with the secret",
+			token:     sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), validClaims()),
+			principal: Principal{Subject: "user-1", Roles: []string{"reader", "editor"}},
+		},
+		{
+			name:      "signed with a key of the set",
+			token:     sign(t, jwt.SigningMethodRS256, testKid, key, validClaims()),
+			principal: Principal{Subject: "user-1", Roles: []string{"reader", "editor"}},
+		},
+		{
+			name:      "roles as a space separated string",
+			token:     sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), withClaim("roles", "reader admin")),
+			principal: Principal{Subject: "user-1", Roles: []string{"reader", "admin"}},
+		},
+		{
+			name:    "expired",
+			token:   sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), withClaim("exp", time.Now().Add(-time.Minute).Unix())),
+			invalid: true,
+		},
+		{
+			name:    "signed with another secret",
+			token:   sign(t, jwt.SigningMethodHS256, "", []byte("other"), validClaims()),
+			invalid: true,
+		},
+		{
+			name:    "signing method there's no key for",
+			token:   sign(t, jwt.SigningMethodES256, "", mustECKey(t), validClaims()),
+			invalid: true,
+		},
+		{
+			name:    "unsigned",
+			token:   sign(t, jwt.SigningMethodNone, "", jwt.UnsafeAllowNoneSignatureType, validClaims()),
+			invalid: true,
+		},
+		{
+			name:    "unknown key id",
+			token:   sign(t, jwt.SigningMethodRS256, "key-2", key, validClaims()),
+			invalid: true,
+		},
+		{
+			name:    "signed with another key under a known key id",
+			token:   sign(t, jwt.SigningMethodRS256, testKid, otherKey, validClaims()),
+			invalid: true,
+		},
+		{
+			name:    "no key id and no public key",
+			token:   sign(t, jwt.SigningMethodRS256, "", key, validClaims()),
+			invalid: true,
+		},
+		{
+			name:    "different issuer",
+			token:   sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), withClaim("iss", "https://other.example")),
+			invalid: true,
+		},
+		{
+			name:    "no issuer",
+			token:   sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), withClaim("iss", nil)),
+			invalid: true,
+		},
+		{
+			name:    "different audience",
+			token:   sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), withClaim("aud", "orders")),
+			invalid: true,
+		},
+		{
+			name:    "no subject",
+			token:   sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), withClaim("sub", nil)),
+			invalid: true,
+		},
+		{
+			name:    "invalid roles",
+			token:   sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), withClaim("roles", 1)),
+			invalid: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := a.Authenticate(tt.token)
+			if tt.invalid {
+				if err == nil {
+					t.Fatalf("got principal %+v, want an error", principal)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(principal, tt.principal) {
+				t.Fatalf("got principal %+v, want %+v", principal, tt.principal)
+			}
+		})
+	}
+}
+
+func TestAuthenticateRSAPublicKey(t *testing.T) {
+	key, _ := newTestKey(t)
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "key.pem")
+	if err = ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := New(Config{RSAPublicKeyFile: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = a.Authenticate(sign(t, jwt.SigningMethodRS256, "", key, validClaims())); err != nil {
+		t.Fatalf("token signed with the key: %v", err)
+	}
+
+	// the secret is empty when only a public key is configured, so an HMAC
+	// token must not verify against it
+	if _, err = a.Authenticate(sign(t, jwt.SigningMethodHS256, "", []byte(""), validClaims())); err == nil {
+		t.Fatal("got a principal for a token signed with HMAC, want an error")
+	}
+}
+
+func TestPrincipalRole(t *testing.T) {
+	tests := []struct {
+		roles []string
+		role  Role
+	}{
+		{roles: nil, role: Public},
+		{roles: []string{"unknown"}, role: Public},
+		{roles: []string{"reader"}, role: Reader},
+		{roles: []string{"Editor", "reader"}, role: Editor},
+		{roles: []string{"reader", "admin", "editor"}, role: Admin},
+	}
+
+	for _, tt := range tests {
+		if role := (Principal{Roles: tt.roles}).Role(); role != tt.role {
+			t.Fatalf("roles %v: got role %s, want %s", tt.roles, role, tt.role)
+		}
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	a, err := New(Config{HMACSecret: testSecret})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	perms := Permissions{
+		"/pkg.Public/":       Public,
+		"/pkg.Service/Read":  Reader,
+		"/pkg.Service/Write": Editor,
+	}
+	reader := sign(t, jwt.SigningMethodHS256, "", []byte(testSecret), withClaim("roles", "reader"))
+
+	tests := []struct {
+		name   string
+		method string
+		header string
+		code   codes.Code
+	}{
+		{name: "public method without a token", method: "/pkg.Public/Check"},
+		{name: "method without a token", method: "/pkg.Service/Read", code: codes.Unauthenticated},
+		{name: "invalid token", method: "/pkg.Service/Read", header: "Bearer x.y.z", code: codes.Unauthenticated},
+		{name: "not a bearer token", method: "/pkg.Service/Read", header: "Basic " + reader, code: codes.Unauthenticated},
+		{name: "role the method requires", method: "/pkg.Service/Read", header: "Bearer " + reader},
+		{name: "role below the one the method requires", method: "/pkg.Service/Write", header: "Bearer " + reader, code: codes.PermissionDenied},
+		{name: "method which isn't listed", method: "/pkg.Service/Delete", header: "Bearer " + reader, code: codes.PermissionDenied},
+	}
+
+	interceptor := a.UnaryServerInterceptor(perms)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.header != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(authorizationHeader, tt.header))
+			}
+
+			var called bool
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				if _, ok := FromContext(ctx); !ok && tt.header != "" {
+					t.Fatal("handler got no principal")
+				}
+				return nil, nil
+			})
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("got code %s (%v), want %s", code, err, tt.code)
+			}
+			if called != (tt.code == codes.OK) {
+				t.Fatalf("handler called: %t, want %t", called, tt.code == codes.OK)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "bearer "
+)
+
+// Permissions maps full method names, or service prefixes such as
+// /grpc.health.v1.Health/, to the role calling them requires. Methods which
+// aren't listed can't be called at all.
+type Permissions map[string]Role
+
+func (p Permissions) required(method string) (Role, bool) {
+	if role, ok := p[method]; ok {
+		return role, true
+	}
+
+	if i := strings.LastIndex(method, "/"); i >= 0 {
+		role, ok := p[method[:i+1]]
+		return role, ok
+	}
+
+	return Public, false
+}
+
+// UnaryServerInterceptor authenticates the bearer token of the request and
+// checks its principal may call the method. The principal is put into the
+// context of the handler.
+func (a *Authenticator) UnaryServerInterceptor(perms Permissions) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authorize(ctx, info.FullMethod, perms)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor is UnaryServerInterceptor for streaming RPCs
+func (a *Authenticator) StreamServerInterceptor(perms Permissions) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authorize(ss.Context(), info.FullMethod, perms)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func (a *Authenticator) authorize(ctx context.Context, method string, perms Permissions) (context.Context, error) {
+	required, ok := perms.required(method)
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "%s can't be called", method)
+	}
+	if required == Public {
+		return ctx, nil
+	}
+
+	token, ok := bearerToken(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "bearer token is required")
+	}
+
+	principal, err := a.Authenticate(token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+	}
+
+	if principal.Role() < required {
+		return nil, status.Errorf(codes.PermissionDenied, "%s role is required to call %s", required, method)
+	}
+
+	return NewContext(ctx, principal), nil
+}
+
+func bearerToken(ctx context.Context) (string, bool) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authorizationHeader)
+	if len(values) == 0 {
+		return "", false
+	}
+
+	value := values[0]
+	if len(value) <= len(bearerPrefix) || !strings.EqualFold(value[:len(bearerPrefix)], bearerPrefix) {
+		return "", false
+	}
+
+	return strings.TrimSpace(value[len(bearerPrefix):]), true
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
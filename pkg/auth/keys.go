@@ -0,0 +1,137 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+
+	"github.com/golang-jwt/jwt"
+)
+
+var (
+	hmacMethods = []string{"HS256", "HS384", "HS512"}
+	rsaMethods  = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512"}
+)
+
+// keySet holds the keys tokens may be signed with
+type keySet struct {
+	secret []byte
+	rsa    *rsa.PublicKey
+	// jwks are RSA keys by their key id
+	jwks map[string]*rsa.PublicKey
+}
+
+func loadKeys(cfg Config) (keySet, error) {
+	var keys keySet
+	if cfg.HMACSecret != "" {
+		keys.secret = []byte(cfg.HMACSecret)
+	}
+
+	if cfg.RSAPublicKeyFile != "" {
+		data, err := ioutil.ReadFile(cfg.RSAPublicKeyFile)
+		if err != nil {
+			return keySet{}, err
+		}
+		if keys.rsa, err = jwt.ParseRSAPublicKeyFromPEM(data); err != nil {
+			return keySet{}, fmt.Errorf("%s: %w", cfg.RSAPublicKeyFile, err)
+		}
+	}
+
+	if cfg.JWKSFile != "" {
+		jwks, err := loadJWKS(cfg.JWKSFile)
+		if err != nil {
+			return keySet{}, fmt.Errorf("%s: %w", cfg.JWKSFile, err)
+		}
+		keys.jwks = jwks
+	}
+
+	if keys.secret == nil && keys.rsa == nil && len(keys.jwks) == 0 {
+		return keySet{}, errors.New("no key to validate tokens with is configured")
+	}
+
+	return keys, nil
+}
+
+// methods are the signing methods there's a key for, tokens signed any other
+// way are rejected before their key is looked up
+func (k keySet) methods() []string {
+	var methods []string
+	if k.secret != nil {
+		methods = append(methods, hmacMethods...)
+	}
+	if k.rsa != nil || len(k.jwks) > 0 {
+		methods = append(methods, rsaMethods...)
+	}
+
+	return methods
+}
+
+func (k keySet) key(token *jwt.Token) (interface{}, error) {
+	switch token.Method.(type) {
+	case *jwt.SigningMethodHMAC:
+		return k.secret, nil
+	case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+		if kid, ok := token.Header["kid"].(string); ok && len(k.jwks) > 0 {
+			if key, ok := k.jwks[kid]; ok {
+				return key, nil
+			}
+			return nil, fmt.Errorf("unknown key id %q", kid)
+		}
+		if k.rsa == nil {
+			return nil, errors.New("token has no key id")
+		}
+		return k.rsa, nil
+	default:
+		return nil, fmt.Errorf("unexpected signing method %s", token.Method.Alg())
+	}
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// loadJWKS reads the RSA signing keys of a JWK set, other keys are skipped
+func loadJWKS(path string) (map[string]*rsa.PublicKey, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err = json.Unmarshal(data, &set); err != nil {
+		return nil, err
+	}
+
+	keys := map[string]*rsa.PublicKey{}
+	for _, key := range set.Keys {
+		if key.Kty != "RSA" || (key.Use != "" && key.Use != "sig") {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(key.N)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: invalid modulus: %w", key.Kid, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(key.E)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: invalid exponent: %w", key.Kid, err)
+		}
+
+		keys[key.Kid] = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	}
+
+	return keys, nil
+}
@@ -1,25 +1,54 @@
 package db
 
 import (
+	"database/sql"
 	"fmt"
 
+	"github.com/XSAM/otelsql"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq" // postgres drivers
+	semconv "go.opentelemetry.io/otel/semconv/v1.7.0"
 
 	"github.com/abdullohsattorov/catalog-service/config"
 )
 
-func ConnectToDB(cfg config.Config) (*sqlx.DB, error) {
-	psqlString := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=disable",
+// ConnString returns the postgres connection string of cfg
+func ConnString(cfg config.Config) string {
+	connStr := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
 		cfg.PostgresHost,
 		cfg.PostgresPort,
 		cfg.PostgresUser,
 		cfg.PostgresPassword,
 		cfg.PostgresDatabase,
+		cfg.PostgresSSLMode,
+	)
+	if cfg.PostgresSSLRootCert != "" {
+		connStr += " sslrootcert=" + cfg.PostgresSSLRootCert
+	}
+
+	return connStr
+}
+
+// ConnectToDB opens the connection pool through a driver which traces the
+// statements of traced requests
+func ConnectToDB(cfg config.Config) (*sqlx.DB, error) {
+	driverName, err := otelsql.Register("postgres", semconv.DBSystemPostgreSQL.Value.AsString(),
+		otelsql.WithSpanOptions(otelsql.SpanOptions{DisableErrSkip: true}),
+		otelsql.WithAttributes(semconv.DBNameKey.String(cfg.PostgresDatabase)),
 	)
-	connDb, err := sqlx.Connect("postgres", psqlString)
 	if err != nil {
 		return nil, err
 	}
+
+	sqlDB, err := sql.Open(driverName, ConnString(cfg))
+	if err != nil {
+		return nil, err
+	}
+
+	connDb := sqlx.NewDb(sqlDB, "postgres")
+	if err = connDb.Ping(); err != nil {
+		_ = connDb.Close()
+		return nil, err
+	}
 	return connDb, nil
 }
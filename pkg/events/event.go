@@ -0,0 +1,31 @@
+package events
+
+import "encoding/json"
+
+// Aggregate types of catalog events
+const (
+	AggregateBook     = "book"
+	AggregateAuthor   = "author"
+	AggregateCategory = "category"
+)
+
+// Event types of catalog events
+const (
+	TypeCreated      = "created"
+	TypeUpdated      = "updated"
+	TypeDeleted      = "deleted"
+	TypePriceChanged = "price_changed"
+)
+
+// Event is a change of a catalog entity recorded in the outbox. Sequence
+// numbers events in the order they were committed, it's 0 until the event
+// is sequenced.
+type Event struct {
+	ID            int64           `json:"id"`
+	Sequence      int64           `json:"sequence"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	EventType     string          `json:"event_type"`
+	Payload       json.RawMessage `json:"payload"`
+	CreatedAt     string          `json:"created_at"`
+}
@@ -0,0 +1,122 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/abdullohsattorov/catalog-service/pkg/logger"
+)
+
+// Channel is the postgres NOTIFY channel outbox inserts are announced on
+const Channel = "catalog_events"
+
+// sequenceInterval is how often committed events are sequenced when no
+// notification arrives, in case one was lost
+const sequenceInterval = time.Second
+
+// Sequencer numbers committed events in commit order, see
+// storage/postgres outboxRepo.AssignSequences
+type Sequencer interface {
+	AssignSequences(ctx context.Context) (int64, error)
+}
+
+// Hub sequences events once they are committed and wakes up change feed
+// subscribers whenever new ones are sequenced
+type Hub struct {
+	seq Sequencer
+
+	mu   sync.Mutex
+	subs map[chan struct{}]struct{}
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+// NewHub ...
+func NewHub(seq Sequencer) *Hub {
+	return &Hub{seq: seq, subs: map[chan struct{}]struct{}{}, done: make(chan struct{})}
+}
+
+// Close tells subscribers to stop, it's called when the server shuts down
+func (h *Hub) Close() {
+	h.closeOnce.Do(func() {
+		close(h.done)
+	})
+}
+
+// Done is closed once the hub is closed
+func (h *Hub) Done() <-chan struct{} {
+	return h.done
+}
+
+// Subscribe returns a channel receiving a value after new events are
+// committed and a function removing the subscription
+func (h *Hub) Subscribe() (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	h.mu.Lock()
+	h.subs[ch] = struct{}{}
+	h.mu.Unlock()
+
+	return ch, func() {
+		h.mu.Lock()
+		delete(h.subs, ch)
+		h.mu.Unlock()
+	}
+}
+
+// Broadcast wakes up every subscriber. Subscribers which haven't consumed
+// the previous wake up are not woken twice.
+func (h *Hub) Broadcast() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subs {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Listen sequences the committed events on every notification on Channel,
+// and every sequenceInterval, until ctx is done. Subscribers are woken when
+// events were sequenced.
+func (h *Hub) Listen(ctx context.Context, connStr string, log logger.Logger) error {
+	listener := pq.NewListener(connStr, time.Second, time.Minute, func(ev pq.ListenerEventType, err error) {
+		if err != nil {
+			log.Warn("catalog events listener", logger.Error(err))
+		}
+	})
+	defer listener.Close()
+
+	if err := listener.Listen(Channel); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(sequenceInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-listener.Notify:
+		case <-ticker.C:
+		}
+
+		n, err := h.seq.AssignSequences(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Error("failed to sequence catalog events", logger.Error(err))
+			}
+			continue
+		}
+		if n > 0 {
+			h.Broadcast()
+		}
+	}
+}
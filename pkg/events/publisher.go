@@ -0,0 +1,114 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// Publisher kinds accepted by NewPublisher
+const (
+	PublisherMemory = "memory"
+	PublisherStdout = "stdout"
+	PublisherFile   = "file"
+)
+
+// Publisher delivers catalog events to other services
+type Publisher interface {
+	Publish(ctx context.Context, events []Event) error
+	Close() error
+}
+
+// NewPublisher creates the publisher of given kind, path is used by the file
+// publisher only
+func NewPublisher(kind, path string) (Publisher, error) {
+	switch kind {
+	case PublisherMemory:
+		return NewMemoryPublisher(), nil
+	case PublisherStdout:
+		return NewWriterPublisher(nopCloser{os.Stdout}), nil
+	case PublisherFile:
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, err
+		}
+		return NewWriterPublisher(f), nil
+	default:
+		return nil, fmt.Errorf("unknown event publisher %q", kind)
+	}
+}
+
+// MemoryPublisher keeps published events in memory
+type MemoryPublisher struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+// NewMemoryPublisher ...
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{}
+}
+
+// Publish ...
+func (p *MemoryPublisher) Publish(ctx context.Context, events []Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.events = append(p.events, events...)
+	return nil
+}
+
+// Events returns every event published so far
+func (p *MemoryPublisher) Events() []Event {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]Event(nil), p.events...)
+}
+
+// Close ...
+func (p *MemoryPublisher) Close() error {
+	return nil
+}
+
+// WriterPublisher writes events as JSON lines
+type WriterPublisher struct {
+	mu sync.Mutex
+	w  io.WriteCloser
+}
+
+// NewWriterPublisher ...
+func NewWriterPublisher(w io.WriteCloser) *WriterPublisher {
+	return &WriterPublisher{w: w}
+}
+
+// Publish ...
+func (p *WriterPublisher) Publish(ctx context.Context, events []Event) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	enc := json.NewEncoder(p.w)
+	for _, event := range events {
+		if err := enc.Encode(event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Close ...
+func (p *WriterPublisher) Close() error {
+	return p.w.Close()
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
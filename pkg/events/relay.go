@@ -0,0 +1,101 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/abdullohsattorov/catalog-service/pkg/logger"
+)
+
+// purgeInterval is how often published events older than the retention
+// are deleted
+const purgeInterval = time.Hour
+
+// Outbox is where the relay takes unpublished events from. PublishPending
+// claims at most limit unpublished events for lease, passes them to publish
+// and marks them published if publish succeeds. PurgePublished deletes
+// events published before the given time.
+type Outbox interface {
+	PublishPending(ctx context.Context, limit int, lease time.Duration, publish func(ctx context.Context, events []Event) error) (int, error)
+	PurgePublished(ctx context.Context, before time.Time) (int64, error)
+}
+
+// RelayOptions configure a relay
+type RelayOptions struct {
+	// Interval is how often pending events are looked for
+	Interval  time.Duration
+	BatchSize int
+	// PublishTimeout bounds publishing a batch, events of a relay which
+	// dies meanwhile are published by another one once it's over
+	PublishTimeout time.Duration
+	// Retention is how long published events are kept, they are kept
+	// forever when it's zero
+	Retention time.Duration
+}
+
+// Relay periodically moves events from the outbox to the publisher
+type Relay struct {
+	outbox    Outbox
+	publisher Publisher
+	log       logger.Logger
+	opts      RelayOptions
+}
+
+// NewRelay ...
+func NewRelay(outbox Outbox, publisher Publisher, log logger.Logger, opts RelayOptions) *Relay {
+	return &Relay{
+		outbox:    outbox,
+		publisher: publisher,
+		log:       log,
+		opts:      opts,
+	}
+}
+
+// Run publishes pending events every interval, and purges old published
+// ones every purgeInterval, until ctx is done
+func (r *Relay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.opts.Interval)
+	defer ticker.Stop()
+
+	var purgedAt time.Time
+	for {
+		r.drain(ctx)
+
+		if r.opts.Retention > 0 && time.Since(purgedAt) >= purgeInterval {
+			r.purge(ctx)
+			purgedAt = time.Now()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (r *Relay) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		n, err := r.outbox.PublishPending(ctx, r.opts.BatchSize, r.opts.PublishTimeout, r.publisher.Publish)
+		if err != nil {
+			r.log.Error("failed to publish outbox events", logger.Error(err))
+			return
+		}
+
+		if n < r.opts.BatchSize {
+			return
+		}
+	}
+}
+
+func (r *Relay) purge(ctx context.Context) {
+	n, err := r.outbox.PurgePublished(ctx, time.Now().Add(-r.opts.Retention))
+	if err != nil {
+		r.log.Error("failed to purge published outbox events", logger.Error(err))
+		return
+	}
+
+	if n > 0 {
+		r.log.Info("purged published outbox events", logger.Int("count", int(n)))
+	}
+}
@@ -0,0 +1,93 @@
+package gateway
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	"github.com/abdullohsattorov/catalog-service/pkg/ratelimit"
+)
+
+// forwardedHeaders are passed between HTTP and grpc metadata under their own
+// names, besides the headers the gateway forwards by default such as
+// Authorization. They map the metadata key to the HTTP header.
+var forwardedHeaders = map[string]string{
+	"x-request-id":         "X-Request-Id",
+	"idempotency-key":      "Idempotency-Key",
+	"idempotency-replayed": "Idempotency-Replayed",
+	"if-match":             "If-Match",
+	"etag":                 "ETag",
+}
+
+// New returns the HTTP/JSON API of CatalogService. Requests are proxied to
+// the grpc server at endpoint, so they go through the same interceptors as
+// grpc calls. Messages are marshalled with the protobuf JSON mapping using
+// the field names of the protos, and grpc status codes are mapped to HTTP
+// statuses. PATCH requests without an update mask update the fields their
+// body sets. Every call carries secret, which tells the rate limiter the
+// address the gateway forwards can be trusted.
+func New(ctx context.Context, endpoint string, creds credentials.TransportCredentials, secret string) (http.Handler, error) {
+	mux := runtime.NewServeMux(
+		runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONPb{OrigName: true, EmitDefaults: true}),
+		runtime.WithIncomingHeaderMatcher(incomingHeader),
+		runtime.WithOutgoingHeaderMatcher(outgoingHeader),
+		runtime.WithProtoErrorHandler(handleError),
+		runtime.WithMetadata(func(context.Context, *http.Request) metadata.MD {
+			return metadata.Pairs(ratelimit.GatewayHeader, secret)
+		}),
+	)
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if err := pb.RegisterCatalogServiceHandlerFromEndpoint(ctx, mux, endpoint, opts); err != nil {
+		return nil, err
+	}
+
+	return patchMask(mux), nil
+}
+
+func incomingHeader(key string) (string, bool) {
+	if name := strings.ToLower(key); forwardedHeaders[name] != "" {
+		return name, true
+	}
+
+	// only the gateway itself sends its secret
+	name, ok := runtime.DefaultHeaderMatcher(key)
+	if ok && strings.EqualFold(name, ratelimit.GatewayHeader) {
+		return "", false
+	}
+
+	return name, ok
+}
+
+func outgoingHeader(key string) (string, bool) {
+	if header, ok := forwardedHeaders[key]; ok {
+		return header, true
+	}
+
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+// handleError writes errors as google.rpc.Status JSON. Errors carrying a
+// RetryInfo, such as rate limited requests, set Retry-After too.
+func handleError(ctx context.Context, mux *runtime.ServeMux, m runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
+	for _, detail := range status.Convert(err).Details() {
+		info, ok := detail.(*errdetails.RetryInfo)
+		if !ok || info.GetRetryDelay() == nil {
+			continue
+		}
+		seconds := math.Ceil(info.GetRetryDelay().AsDuration().Seconds())
+		w.Header().Set("Retry-After", strconv.Itoa(int(seconds)))
+	}
+
+	runtime.DefaultHTTPProtoErrorHandler(ctx, mux, m, w, r, err)
+}
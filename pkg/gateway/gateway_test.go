@@ -0,0 +1,42 @@
+package gateway
+
+import "testing"
+
+func TestIncomingHeader(t *testing.T) {
+	tests := []struct {
+		header string
+		key    string
+	}{
+		{header: "If-Match", key: "if-match"},
+		{header: "Idempotency-Key", key: "idempotency-key"},
+		{header: "X-Request-Id", key: "x-request-id"},
+		{header: "Grpc-Metadata-Locale", key: "Locale"},
+		{header: "X-Catalog-Gateway"},
+		{header: "Grpc-Metadata-X-Catalog-Gateway"},
+	}
+
+	for _, tt := range tests {
+		key, ok := incomingHeader(tt.header)
+		if ok != (tt.key != "") || key != tt.key {
+			t.Fatalf("%s: got metadata key %q (forwarded: %t), want %q", tt.header, key, ok, tt.key)
+		}
+	}
+}
+
+func TestOutgoingHeader(t *testing.T) {
+	tests := []struct {
+		key    string
+		header string
+	}{
+		{key: "etag", header: "ETag"},
+		{key: "idempotency-replayed", header: "Idempotency-Replayed"},
+		{key: "x-request-id", header: "X-Request-Id"},
+		{key: "other", header: "Grpc-Metadata-other"},
+	}
+
+	for _, tt := range tests {
+		if header, ok := outgoingHeader(tt.key); !ok || header != tt.header {
+			t.Fatalf("%s: got header %q, want %q", tt.key, header, tt.header)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package gateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// patchResources are the request fields holding the resource of PATCH
+// methods, with the fields of each resource a PATCH never updates: its id,
+// its version which is checked instead, and the ones set by the server
+var patchResources = map[string]map[string]bool{
+	"book":     fieldSet("BookId", "Version", "CreatedAt", "UpdatedAt", "Categories", "AverageRating", "ReviewCount"),
+	"author":   fieldSet("AuthorId", "Version", "CreatedAt", "UpdatedAt"),
+	"category": fieldSet("CategoryId", "Version", "CreatedAt", "UpdatedAt", "ParentCategory"),
+}
+
+// categoryChanges are the fields of book updates adding or removing
+// categories, which change the book without a mask
+var categoryChanges = fieldSet("AddCategoryIds", "RemoveCategoryIds")
+
+func fieldSet(fields ...string) map[string]bool {
+	set := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		set[fieldKey(field)] = true
+	}
+
+	return set
+}
+
+// fieldKey spells a JSON field the same whether it is written as the proto
+// field name, in lowerCamelCase or in snake_case
+func fieldKey(field string) string {
+	return strings.ToLower(strings.ReplaceAll(field, "_", ""))
+}
+
+// patchMask gives PATCH requests without an UpdateMask the fields present
+// in the body as their mask, so they update only those fields. Updates take
+// an empty mask as every field, which isn't what a PATCH means. A mask sent
+// in the JSON form of google.protobuf.FieldMask, "Name,Price", is passed on
+// as the list of paths the gateway decodes.
+func patchMask(mux *runtime.ServeMux) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPatch {
+			mux.ServeHTTP(w, r)
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			_, outbound := runtime.MarshalerForRequest(mux, r)
+			runtime.HTTPError(r.Context(), mux, outbound, w, r, status.Errorf(codes.InvalidArgument, "failed to read body: %v", err))
+			return
+		}
+
+		if masked, err := withPatchMask(body); err != nil {
+			_, outbound := runtime.MarshalerForRequest(mux, r)
+			runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+			return
+		} else if masked != nil {
+			body = masked
+		}
+
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		r.ContentLength = int64(len(body))
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// withPatchMask returns the body with the mask filled in, or nil when the
+// body is left as it is: it isn't an update of a known resource or it can't
+// be parsed, which the gateway reports itself
+func withPatchMask(body []byte) ([]byte, error) {
+	var req map[string]json.RawMessage
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, nil
+	}
+
+	var (
+		resource          map[string]json.RawMessage
+		ignored           map[string]bool
+		changesCategories bool
+		paths             []string
+	)
+	for key, value := range req {
+		switch k := fieldKey(key); {
+		case k == "updatemask":
+			var ok bool
+			if paths, ok = maskPaths(value); !ok {
+				return nil, nil
+			}
+			delete(req, key)
+		case patchResources[k] != nil:
+			if err := json.Unmarshal(value, &resource); err != nil {
+				return nil, nil
+			}
+			ignored = patchResources[k]
+		case categoryChanges[k]:
+			changesCategories = string(value) != "null" && string(value) != "[]"
+		}
+	}
+	if ignored == nil {
+		return nil, nil
+	}
+
+	if len(paths) == 0 {
+		paths = []string{}
+		for field := range resource {
+			if !ignored[fieldKey(field)] {
+				paths = append(paths, field)
+			}
+		}
+		sort.Strings(paths)
+		if len(paths) == 0 && !changesCategories {
+			return nil, status.Error(codes.InvalidArgument, "the body of a PATCH has to set a field or an UpdateMask")
+		}
+	}
+
+	mask, err := json.Marshal(map[string][]string{"paths": paths})
+	if err != nil {
+		return nil, err
+	}
+	req["UpdateMask"] = mask
+
+	return json.Marshal(req)
+}
+
+// maskPaths reads an UpdateMask written as a comma separated string, the
+// JSON form of google.protobuf.FieldMask, or as an object with its paths
+func maskPaths(value json.RawMessage) ([]string, bool) {
+	var joined string
+	if err := json.Unmarshal(value, &joined); err == nil {
+		var paths []string
+		for _, path := range strings.Split(joined, ",") {
+			if path = strings.TrimSpace(path); path != "" {
+				paths = append(paths, path)
+			}
+		}
+		return paths, true
+	}
+
+	var mask struct {
+		Paths []string `json:"paths"`
+	}
+	if err := json.Unmarshal(value, &mask); err != nil {
+		return nil, false
+	}
+
+	return mask.Paths, true
+}
@@ -0,0 +1,117 @@
+package gateway
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+)
+
+func TestWithPatchMask(t *testing.T) {
+	tests := []struct {
+		name  string
+		body  string
+		paths []string
+		kept  bool
+		code  codes.Code
+	}{
+		{
+			name:  "fields of the body",
+			body:  `{"Book": {"Name": "x", "price": 5, "Version": 3}}`,
+			paths: []string{"Name", "price"},
+		},
+		{
+			name:  "fields set by the server are left out",
+			body:  `{"Category": {"CategoryId": "c1", "ParentCategory": "p", "ParentUuid": "p1", "updated_at": ""}}`,
+			paths: []string{"ParentUuid"},
+		},
+		{
+			name:  "categories added without other fields",
+			body:  `{"Book": {"Version": 3}, "AddCategoryIds": ["c1"]}`,
+			paths: []string{},
+		},
+		{
+			name: "empty mask and no fields",
+			body: `{"Author": {"Version": 3}, "UpdateMask": ""}`,
+			code: codes.InvalidArgument,
+		},
+		{
+			name:  "mask sent by the client",
+			body:  `{"Book": {"Name": "x", "Price": 5}, "UpdateMask": "Name, author_id"}`,
+			paths: []string{"Name", "author_id"},
+		},
+		{
+			name:  "mask sent as a list of paths",
+			body:  `{"Book": {"Name": "x", "Price": 5}, "UpdateMask": {"Paths": ["Price"]}}`,
+			paths: []string{"Price"},
+		},
+		{
+			name:  "empty list of paths",
+			body:  `{"Book": {"Name": "x"}, "UpdateMask": {"paths": []}}`,
+			paths: []string{"Name"},
+		},
+		{
+			name: "not an update",
+			body: `{"Name": "x"}`,
+			kept: true,
+		},
+		{
+			name: "invalid json",
+			body: `{"Book": `,
+			kept: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, err := withPatchMask([]byte(tt.body))
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("got code %s (%v), want %s", code, err, tt.code)
+			}
+			if err != nil {
+				return
+			}
+
+			if tt.kept {
+				if body != nil {
+					t.Fatalf("got body %s, want it kept", body)
+				}
+				return
+			}
+
+			var req struct {
+				UpdateMask struct {
+					Paths []string `json:"paths"`
+				}
+			}
+			if err = json.Unmarshal(body, &req); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(req.UpdateMask.Paths, tt.paths) {
+				t.Fatalf("got paths %v, want %v", req.UpdateMask.Paths, tt.paths)
+			}
+		})
+	}
+}
+
+func TestPatchMaskIsDecoded(t *testing.T) {
+	body, err := withPatchMask([]byte(`{"Book": {"BookId": "b1", "Price": 5}, "UpdateMask": "Price,Name"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var req pb.UpdateBookReq
+	marshaler := &runtime.JSONPb{OrigName: true, EmitDefaults: true}
+	if err = marshaler.NewDecoder(bytes.NewReader(body)).Decode(&req); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"Price", "Name"}; !reflect.DeepEqual(req.UpdateMask.GetPaths(), want) {
+		t.Fatalf("got paths %v, want %v", req.UpdateMask.GetPaths(), want)
+	}
+}
@@ -0,0 +1,103 @@
+package health
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/health"
+	healthPb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/abdullohsattorov/catalog-service/pkg/logger"
+)
+
+// Pinger is what the checker pings to see if the database is reachable
+type Pinger interface {
+	PingContext(ctx context.Context) error
+}
+
+// Downstream reports connectivity states of connections to other services
+type Downstream interface {
+	States() map[string]connectivity.State
+}
+
+// Checker keeps statuses of the grpc.health.v1 server up to date. Services
+// depending on the database are serving while it answers pings, every
+// downstream service has its own status named after it. The empty service
+// name reports the server as a whole and follows the database.
+type Checker struct {
+	server     *health.Server
+	db         Pinger
+	downstream Downstream
+	services   []string
+	interval   time.Duration
+	log        logger.Logger
+}
+
+// NewChecker ...
+func NewChecker(db Pinger, downstream Downstream, interval time.Duration, log logger.Logger, services ...string) *Checker {
+	c := &Checker{
+		server:     health.NewServer(),
+		db:         db,
+		downstream: downstream,
+		services:   append([]string{""}, services...),
+		interval:   interval,
+		log:        log,
+	}
+
+	// nothing is serving until the first check passes
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, healthPb.HealthCheckResponse_NOT_SERVING)
+	}
+
+	return c
+}
+
+// Server returns the health service to register on the grpc server
+func (c *Checker) Server() healthPb.HealthServer {
+	return c.server
+}
+
+// Run checks the health every interval until ctx is done
+func (c *Checker) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		c.check(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Shutdown sets every status to NOT_SERVING for good, so load balancers stop
+// sending requests before the server stops
+func (c *Checker) Shutdown() {
+	c.server.Shutdown()
+}
+
+func (c *Checker) check(ctx context.Context) {
+	pingCtx, cancel := context.WithTimeout(ctx, c.interval)
+	defer cancel()
+
+	status := healthPb.HealthCheckResponse_SERVING
+	if err := c.db.PingContext(pingCtx); err != nil {
+		c.log.Error("health check: database is unreachable", logger.Error(err))
+		status = healthPb.HealthCheckResponse_NOT_SERVING
+	}
+	for _, service := range c.services {
+		c.server.SetServingStatus(service, status)
+	}
+
+	for name, state := range c.downstream.States() {
+		status := healthPb.HealthCheckResponse_SERVING
+		if state == connectivity.TransientFailure || state == connectivity.Shutdown {
+			status = healthPb.HealthCheckResponse_NOT_SERVING
+		}
+		c.server.SetServingStatus(name, status)
+	}
+}
@@ -14,3 +14,9 @@ const (
 	// LevelFatal ...
 	LevelFatal = "fatal"
 )
+
+// Encodings of log entries
+const (
+	EncodingJSON    = "json"
+	EncodingConsole = "console"
+)
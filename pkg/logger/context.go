@@ -0,0 +1,19 @@
+package logger
+
+import "context"
+
+type loggerKey struct{}
+
+// NewContext returns a copy of ctx carrying l
+func NewContext(ctx context.Context, l Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, l)
+}
+
+// FromContext returns the logger carried by ctx, or fallback if it has none
+func FromContext(ctx context.Context, fallback Logger) Logger {
+	if l, ok := ctx.Value(loggerKey{}).(Logger); ok {
+		return l
+	}
+
+	return fallback
+}
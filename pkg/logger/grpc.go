@@ -0,0 +1,162 @@
+package logger
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// RequestIDHeader is the metadata key request ids are read from, sent back
+// in and passed on to downstream services with
+const RequestIDHeader = "x-request-id"
+
+const maxRequestIDLength = 128
+
+// healthPrefix is the prefix of health check methods, which are logged at
+// debug level since probes call them all the time
+const healthPrefix = "/grpc.health.v1.Health/"
+
+type requestIDKey struct{}
+
+// RequestIDFromContext returns the id of the request ctx belongs to
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// UnaryServerInterceptor gives every request an id, taking the caller's if
+// it sent one, and a logger adding it to every entry. Each RPC is logged
+// with its method, duration, status code and peer once it's handled.
+func UnaryServerInterceptor(l Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		ctx = newRequestContext(ctx, l)
+
+		resp, err := handler(ctx, req)
+		logRPC(ctx, l, info.FullMethod, start, err)
+
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor is UnaryServerInterceptor for streaming RPCs
+func StreamServerInterceptor(l Logger) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		start := time.Now()
+		ctx := newRequestContext(ss.Context(), l)
+
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+		logRPC(ctx, l, info.FullMethod, start, err)
+
+		return err
+	}
+}
+
+// UnaryClientInterceptor passes the id of the request being handled on to
+// downstream services
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption,
+	) error {
+		if id := RequestIDFromContext(ctx); id != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, RequestIDHeader, id)
+		}
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func newRequestContext(ctx context.Context, l Logger) context.Context {
+	id := incomingRequestID(ctx)
+	_ = grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, id))
+
+	fields := []Field{String("request_id", id)}
+	if span := trace.SpanContextFromContext(ctx); span.IsValid() {
+		fields = append(fields, String("trace_id", span.TraceID().String()))
+	}
+
+	ctx = context.WithValue(ctx, requestIDKey{}, id)
+	return NewContext(ctx, l.With(fields...))
+}
+
+// incomingRequestID returns the request id sent by the caller, or a new one
+// if it sent none or one that isn't fit for logs
+func incomingRequestID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if ids := md.Get(RequestIDHeader); len(ids) > 0 && validRequestID(ids[0]) {
+		return ids[0]
+	}
+
+	return uuid.Must(uuid.NewV4()).String()
+}
+
+func validRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+
+	return strings.IndexFunc(id, func(r rune) bool {
+		return r < '!' || r > '~'
+	}) < 0
+}
+
+func logRPC(ctx context.Context, l Logger, method string, start time.Time, err error) {
+	code := status.Code(err)
+	fields := []Field{
+		String("method", method),
+		Duration("duration", time.Since(start)),
+		String("code", code.String()),
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		fields = append(fields, String("peer", p.Addr.String()))
+	}
+	if err != nil {
+		fields = append(fields, Error(err))
+	}
+
+	log := FromContext(ctx, l)
+	switch level := rpcLevel(method, code); level {
+	case zapcore.DebugLevel:
+		log.Debug("rpc", fields...)
+	case zapcore.WarnLevel:
+		log.Warn("rpc", fields...)
+	case zapcore.ErrorLevel:
+		log.Error("rpc", fields...)
+	default:
+		log.Info("rpc", fields...)
+	}
+}
+
+// rpcLevel logs failures caused by the server as errors and ones caused by
+// the caller as warnings
+func rpcLevel(method string, code codes.Code) zapcore.Level {
+	switch code {
+	case codes.OK:
+		if strings.HasPrefix(method, healthPrefix) {
+			return zapcore.DebugLevel
+		}
+		return zapcore.InfoLevel
+	case codes.Unknown, codes.Internal, codes.DataLoss, codes.Unimplemented, codes.DeadlineExceeded, codes.Unavailable:
+		return zapcore.ErrorLevel
+	default:
+		return zapcore.WarnLevel
+	}
+}
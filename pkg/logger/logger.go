@@ -1,6 +1,7 @@
 package logger
 
 import (
+	"net/http"
 	"time"
 
 	"go.uber.org/zap"
@@ -19,6 +20,8 @@ var (
 	Error = zap.Error
 	// Bool ...
 	Bool = zap.Bool
+	// Duration ...
+	Duration = zap.Duration
 
 	// Any ...
 	Any = zap.Any
@@ -31,22 +34,34 @@ type Logger interface {
 	Warn(msg string, fields ...Field)
 	Error(msg string, fields ...Field)
 	Fatal(msg string, fields ...Field)
+	// With returns a logger adding fields to every entry
+	With(fields ...Field) Logger
 }
 
 type LoggerImpl struct {
 	zap *zap.Logger
+	// level is shared by the loggers derived from this one, so changing it
+	// changes the level of all of them
+	level zap.AtomicLevel
 }
 
 var customTimeFormat string
 
 // New ...
-func New(level, namespace string) *LoggerImpl {
+func New(level, namespace string, opts ...Option) *LoggerImpl {
 	if level == "" {
 		level = LevelInfo
 	}
 
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	atomicLevel := zap.NewAtomicLevelAt(parseLevel(level))
 	logger := LoggerImpl{
-		zap: newZapLogger(level, time.RFC3339),
+		zap:   newZapLogger(atomicLevel, time.RFC3339, o),
+		level: atomicLevel,
 	}
 
 	logger.zap = logger.zap.Named(namespace)
@@ -76,6 +91,32 @@ func (l *LoggerImpl) Fatal(msg string, fields ...Field) {
 	l.zap.Fatal(msg, fields...)
 }
 
+func (l *LoggerImpl) With(fields ...Field) Logger {
+	return &LoggerImpl{zap: l.zap.With(fields...), level: l.level}
+}
+
+// Level returns the current level
+func (l *LoggerImpl) Level() string {
+	return l.level.String()
+}
+
+// SetLevel changes the level of the logger and every logger derived from it
+func (l *LoggerImpl) SetLevel(level string) error {
+	var lvl zapcore.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		return err
+	}
+
+	l.level.SetLevel(lvl)
+	return nil
+}
+
+// LevelHandler serves the level of the logger over http. GET returns it as
+// {"level":"info"}, PUT with the same body changes it.
+func (l *LoggerImpl) LevelHandler() http.Handler {
+	return l.level
+}
+
 // GetNamed ...
 func GetNamed(l Logger, name string) Logger {
 	switch v := l.(type) {
@@ -90,15 +131,7 @@ func GetNamed(l Logger, name string) Logger {
 
 // WithFields ...
 func WithFields(l Logger, fields ...Field) Logger {
-	switch v := l.(type) {
-	case *LoggerImpl:
-		return &LoggerImpl{
-			zap: v.zap.With(fields...),
-		}
-	default:
-		l.Info("logger.WithFields: invalid logger type")
-		return l
-	}
+	return l.With(fields...)
 }
 
 // Cleanup ...
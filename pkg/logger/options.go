@@ -0,0 +1,26 @@
+package logger
+
+type options struct {
+	encoding           string
+	samplingInitial    int
+	samplingThereafter int
+}
+
+// Option configures a logger made by New
+type Option func(o *options)
+
+// WithEncoding writes entries as JSON or as console friendly text
+func WithEncoding(encoding string) Option {
+	return func(o *options) {
+		o.encoding = encoding
+	}
+}
+
+// WithSampling logs the first initial debug entries with the same message
+// every second, then every thereafter-th one. Zero initial disables sampling.
+func WithSampling(initial, thereafter int) Option {
+	return func(o *options) {
+		o.samplingInitial = initial
+		o.samplingThereafter = thereafter
+	}
+}
@@ -8,15 +8,17 @@ import (
 	"go.uber.org/zap/zapcore"
 )
 
-func newZapLogger(level, timeFormat string) *zap.Logger {
-	globalLevel := parseLevel(level)
-
+func newZapLogger(level zap.AtomicLevel, timeFormat string, opts options) *zap.Logger {
 	highPriority := zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
 		return lvl >= zapcore.ErrorLevel
 	})
 
 	lowPriority := zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
-		return lvl >= globalLevel && lvl < zapcore.ErrorLevel
+		return level.Enabled(lvl) && lvl > zapcore.DebugLevel && lvl < zapcore.ErrorLevel
+	})
+
+	debug := zap.LevelEnablerFunc(func(lvl zapcore.Level) bool {
+		return level.Enabled(lvl) && lvl == zapcore.DebugLevel
 	})
 
 	consoleInfos := zapcore.Lock(os.Stdout)
@@ -30,11 +32,25 @@ func newZapLogger(level, timeFormat string) *zap.Logger {
 	} else {
 		encoderCfg.EncodeTime = zapcore.ISO8601TimeEncoder
 	}
-	consoleEncoder := zapcore.NewJSONEncoder(encoderCfg)
+
+	var consoleEncoder zapcore.Encoder
+	if opts.encoding == EncodingConsole {
+		encoderCfg.EncodeLevel = zapcore.CapitalLevelEncoder
+		consoleEncoder = zapcore.NewConsoleEncoder(encoderCfg)
+	} else {
+		consoleEncoder = zapcore.NewJSONEncoder(encoderCfg)
+	}
+
+	// only debug entries are sampled, so no error or warning is ever dropped
+	debugCore := zapcore.NewCore(consoleEncoder, consoleInfos, debug)
+	if opts.samplingInitial > 0 {
+		debugCore = zapcore.NewSamplerWithOptions(debugCore, time.Second, opts.samplingInitial, opts.samplingThereafter)
+	}
 
 	core := zapcore.NewTee(
 		zapcore.NewCore(consoleEncoder, consoleErrors, highPriority),
 		zapcore.NewCore(consoleEncoder, consoleInfos, lowPriority),
+		debugCore,
 	)
 
 	logger := zap.New(core)
@@ -64,7 +80,7 @@ func parseLevel(level string) zapcore.Level {
 // GetZapLogger extracts zap struct from given logger interface
 func GetZapLogger(l Logger) *zap.Logger {
 	if l == nil {
-		return newZapLogger(LevelInfo, time.RFC3339)
+		return newZapLogger(zap.NewAtomicLevelAt(zapcore.InfoLevel), time.RFC3339, options{})
 	}
 
 	switch v := l.(type) {
@@ -72,6 +88,6 @@ func GetZapLogger(l Logger) *zap.Logger {
 		return v.zap
 	default:
 		l.Info("logger.WithFields: invalid logger type, creating a new zap logger", String("level", LevelInfo), String("time_format", time.RFC3339))
-		return newZapLogger(LevelInfo, time.RFC3339)
+		return newZapLogger(zap.NewAtomicLevelAt(zapcore.InfoLevel), time.RFC3339, options{})
 	}
 }
@@ -0,0 +1,127 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// RPC types as they are labelled
+const (
+	rpcUnary        = "unary"
+	rpcClientStream = "client_stream"
+	rpcServerStream = "server_stream"
+	rpcBidiStream   = "bidi_stream"
+)
+
+// Metrics collects request metrics of the grpc server, latencies of queries
+// made by repositories and stats of the database connection pool
+type Metrics struct {
+	registry *prometheus.Registry
+
+	rpcHandled  *prometheus.CounterVec
+	rpcDuration *prometheus.HistogramVec
+
+	queryDuration *prometheus.HistogramVec
+}
+
+// New returns metrics registered with their own registry, along with Go
+// runtime and process metrics. Pool stats of db are collected on scrape.
+func New(db *sql.DB, dbName string) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		rpcHandled: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_server_handled_total",
+			Help: "Total number of RPCs completed on the server, by method and status code.",
+		}, []string{"grpc_service", "grpc_method", "grpc_type", "grpc_code"}),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "grpc_server_handling_seconds",
+			Help:    "Time taken by the server to handle RPCs, by method.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"grpc_service", "grpc_method", "grpc_type"}),
+		queryDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "catalog_db_query_duration_seconds",
+			Help:    "Time taken by repository methods to query the database.",
+			Buckets: []float64{.001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"repository", "method"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		collectors.NewDBStatsCollector(db, dbName),
+		m.rpcHandled,
+		m.rpcDuration,
+		m.queryDuration,
+	)
+
+	return m
+}
+
+// Handler serves the metrics to prometheus
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// ObserveQuery records how long the method of a repository took since start
+func (m *Metrics) ObserveQuery(repository, method string, start time.Time) {
+	m.queryDuration.WithLabelValues(repository, method).Observe(time.Since(start).Seconds())
+}
+
+// UnaryServerInterceptor counts and times unary RPCs
+func (m *Metrics) UnaryServerInterceptor(
+	ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	m.observeRPC(info.FullMethod, rpcUnary, start, err)
+
+	return resp, err
+}
+
+// StreamServerInterceptor counts streaming RPCs and times them until the
+// stream is closed
+func (m *Metrics) StreamServerInterceptor(
+	srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	m.observeRPC(info.FullMethod, streamType(info), start, err)
+
+	return err
+}
+
+func (m *Metrics) observeRPC(fullMethod, rpcType string, start time.Time, err error) {
+	service, method := splitMethod(fullMethod)
+	m.rpcDuration.WithLabelValues(service, method, rpcType).Observe(time.Since(start).Seconds())
+	m.rpcHandled.WithLabelValues(service, method, rpcType, status.Code(err).String()).Inc()
+}
+
+func streamType(info *grpc.StreamServerInfo) string {
+	switch {
+	case info.IsClientStream && info.IsServerStream:
+		return rpcBidiStream
+	case info.IsClientStream:
+		return rpcClientStream
+	default:
+		return rpcServerStream
+	}
+}
+
+// splitMethod splits /package.Service/Method into the service and the method
+func splitMethod(fullMethod string) (string, string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.Index(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+
+	return "unknown", fullMethod
+}
@@ -0,0 +1,150 @@
+package ratelimit
+
+import (
+	"context"
+	"crypto/subtle"
+	"net"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/abdullohsattorov/catalog-service/pkg/auth"
+)
+
+// healthPrefix is the prefix of health check methods, which are never
+// limited so probes keep working under load
+const healthPrefix = "/grpc.health.v1.Health/"
+
+// inFlightRetryDelay is the retry hint of requests rejected since too many
+// are handled at once
+const inFlightRetryDelay = time.Second
+
+// UnaryServerInterceptor rejects requests over the rate limit of their
+// client and method, or over the in-flight cap, with ResourceExhausted. The
+// error carries a RetryInfo telling when to try again.
+func (l *Limiter) UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	client := l.clientOf(ctx)
+	ctx = withoutGatewayHeader(ctx)
+
+	if strings.HasPrefix(info.FullMethod, healthPrefix) {
+		return handler(ctx, req)
+	}
+
+	if delay, ok := l.allow(client, info.FullMethod); !ok {
+		return nil, exhausted(delay, "rate limit of %s exceeded", info.FullMethod)
+	}
+
+	release, ok := l.acquire()
+	if !ok {
+		return nil, exhausted(inFlightRetryDelay, "too many requests in flight")
+	}
+	defer release()
+
+	return handler(ctx, req)
+}
+
+// StreamServerInterceptor rate limits opening streams. Streams don't count
+// towards the in-flight cap, since change feeds stay open for long.
+func (l *Limiter) StreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	client := l.clientOf(ss.Context())
+	ss = &serverStream{ServerStream: ss, ctx: withoutGatewayHeader(ss.Context())}
+
+	if strings.HasPrefix(info.FullMethod, healthPrefix) {
+		return handler(srv, ss)
+	}
+
+	if delay, ok := l.allow(client, info.FullMethod); !ok {
+		return exhausted(delay, "rate limit of %s exceeded", info.FullMethod)
+	}
+
+	return handler(srv, ss)
+}
+
+// GatewayHeader carries the secret the HTTP gateway of this process sends
+// with its calls, see Config.GatewaySecret
+const GatewayHeader = "x-catalog-gateway"
+
+// forwardedForHeader carries the address of callers of the HTTP gateway,
+// which appends it to the addresses sent by the caller
+const forwardedForHeader = "x-forwarded-for"
+
+// clientOf identifies the caller by the subject of its token, or by its
+// address when it's anonymous. Calls from the gateway are told apart by the
+// address it forwards, which is only trusted on calls carrying its secret.
+func (l *Limiter) clientOf(ctx context.Context) string {
+	if principal, ok := auth.FromContext(ctx); ok {
+		return "sub:" + principal.Subject
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if l.fromGateway(md) {
+		if forwarded := md.Get(forwardedForHeader); len(forwarded) > 0 {
+			hops := strings.Split(forwarded[len(forwarded)-1], ",")
+			return "addr:" + strings.TrimSpace(hops[len(hops)-1])
+		}
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "unknown"
+	}
+
+	addr := p.Addr.String()
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+
+	return "addr:" + addr
+}
+
+func (l *Limiter) fromGateway(md metadata.MD) bool {
+	if l.cfg.GatewaySecret == "" {
+		return false
+	}
+
+	secrets := md.Get(GatewayHeader)
+	return len(secrets) == 1 && subtle.ConstantTimeCompare([]byte(secrets[0]), []byte(l.cfg.GatewaySecret)) == 1
+}
+
+// withoutGatewayHeader keeps the secret of the gateway from the handlers
+func withoutGatewayHeader(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(GatewayHeader)) == 0 {
+		return ctx
+	}
+
+	md = md.Copy()
+	delete(md, GatewayHeader)
+
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func exhausted(delay time.Duration, format string, args ...interface{}) error {
+	st := status.Newf(codes.ResourceExhausted, format, args...)
+	if delay <= 0 {
+		return st.Err()
+	}
+
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
@@ -0,0 +1,177 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/abdullohsattorov/catalog-service/pkg/auth"
+)
+
+const gatewaySecret = "s3cret"
+
+// fromPeer is the context of a call from addr with pairs as its metadata
+func fromPeer(addr string, pairs ...string) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(addr), Port: 40000}})
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(pairs...))
+}
+
+func TestClientOf(t *testing.T) {
+	tests := []struct {
+		name   string
+		secret string
+		ctx    context.Context
+		client string
+	}{
+		{
+			name:   "address of the peer",
+			secret: gatewaySecret,
+			ctx:    fromPeer("10.0.0.1"),
+			client: "addr:10.0.0.1",
+		},
+		{
+			name:   "forwarded address without the secret is spoofed",
+			secret: gatewaySecret,
+			ctx:    fromPeer("10.0.0.1", forwardedForHeader, "203.0.113.7"),
+			client: "addr:10.0.0.1",
+		},
+		{
+			name:   "forwarded address with a wrong secret",
+			secret: gatewaySecret,
+			ctx:    fromPeer("10.0.0.1", forwardedForHeader, "203.0.113.7", GatewayHeader, "guess"),
+			client: "addr:10.0.0.1",
+		},
+		{
+			name:   "secret sent twice",
+			secret: gatewaySecret,
+			ctx:    fromPeer("10.0.0.1", forwardedForHeader, "203.0.113.7", GatewayHeader, gatewaySecret, GatewayHeader, "guess"),
+			client: "addr:10.0.0.1",
+		},
+		{
+			name:   "no secret configured trusts no forwarded address",
+			ctx:    fromPeer("127.0.0.1", forwardedForHeader, "203.0.113.7", GatewayHeader, ""),
+			client: "addr:127.0.0.1",
+		},
+		{
+			name:   "call from the gateway",
+			secret: gatewaySecret,
+			ctx:    fromPeer("127.0.0.1", forwardedForHeader, "203.0.113.7", GatewayHeader, gatewaySecret),
+			client: "addr:203.0.113.7",
+		},
+		{
+			name:   "addresses prepended by the caller of the gateway are skipped",
+			secret: gatewaySecret,
+			ctx:    fromPeer("127.0.0.1", forwardedForHeader, "198.51.100.1, 203.0.113.7", GatewayHeader, gatewaySecret),
+			client: "addr:203.0.113.7",
+		},
+		{
+			name:   "subject of the token",
+			secret: gatewaySecret,
+			ctx:    auth.NewContext(fromPeer("10.0.0.1"), auth.Principal{Subject: "user-1"}),
+			client: "sub:user-1",
+		},
+		{
+			name:   "no peer",
+			ctx:    context.Background(),
+			client: "unknown",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := New(Config{GatewaySecret: tt.secret})
+			if client := l.clientOf(tt.ctx); client != tt.client {
+				t.Fatalf("got client %q, want %q", client, tt.client)
+			}
+		})
+	}
+}
+
+var getBookInfo = &grpc.UnaryServerInfo{FullMethod: "/catalog.CatalogService/GetBook"}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	l := New(Config{Default: Limit{Rate: 1, Burst: 1}, GatewaySecret: gatewaySecret})
+	ctx := fromPeer("127.0.0.1", forwardedForHeader, "203.0.113.7", GatewayHeader, gatewaySecret)
+
+	_, err := l.UnaryServerInterceptor(ctx, nil, getBookInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if len(md.Get(GatewayHeader)) > 0 {
+			t.Fatal("the handler got the secret of the gateway")
+		}
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = l.UnaryServerInterceptor(ctx, nil, getBookInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+		t.Fatal("request over the limit was handled")
+		return nil, nil
+	})
+	checkExhausted(t, err)
+
+	// health checks are never limited
+	health := &grpc.UnaryServerInfo{FullMethod: healthPrefix + "Check"}
+	for i := 0; i < 3; i++ {
+		if _, err = l.UnaryServerInterceptor(ctx, nil, health, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestUnaryServerInterceptorInFlight(t *testing.T) {
+	l := New(Config{MaxInFlight: 1})
+
+	handling, done := make(chan struct{}), make(chan error)
+	go func() {
+		_, err := l.UnaryServerInterceptor(fromPeer("10.0.0.1"), nil, getBookInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+			close(handling)
+			<-done
+			return nil, nil
+		})
+		done <- err
+	}()
+	<-handling
+
+	_, err := l.UnaryServerInterceptor(fromPeer("10.0.0.2"), nil, getBookInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+		t.Fatal("request over the in-flight cap was handled")
+		return nil, nil
+	})
+	checkExhausted(t, err)
+
+	done <- nil
+	if err = <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	// the slot is released once the first request is handled
+	if _, err = l.UnaryServerInterceptor(fromPeer("10.0.0.2"), nil, getBookInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func checkExhausted(t *testing.T, err error) {
+	t.Helper()
+
+	st := status.Convert(err)
+	if st.Code() != codes.ResourceExhausted {
+		t.Fatalf("got %v, want ResourceExhausted", err)
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok && info.RetryDelay.AsDuration() > 0 {
+			return
+		}
+	}
+	t.Fatalf("got details %v, want a RetryInfo", st.Details())
+}
@@ -0,0 +1,200 @@
+package ratelimit
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// idleTTL is how long the bucket of a client which stopped calling is kept.
+// Buckets idle this long are full again, so dropping them changes nothing.
+const idleTTL = 10 * time.Minute
+
+// Limit is a token bucket, Rate requests a second with bursts of Burst. A
+// zero Rate means no limit.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+// Limits maps full method names, or service prefixes such as
+// /catalog.CatalogService/, to the limit of each client calling them.
+// Methods which aren't listed get the default limit.
+type Limits map[string]Limit
+
+func (l Limits) of(method string, fallback Limit) Limit {
+	if limit, ok := l[method]; ok {
+		return limit
+	}
+
+	if i := strings.LastIndex(method, "/"); i >= 0 {
+		if limit, ok := l[method[:i+1]]; ok {
+			return limit
+		}
+	}
+
+	return fallback
+}
+
+// ParseLimits parses limits written as method=rate:burst pairs separated by
+// commas, e.g. /catalog.CatalogService/ListBook=5:10. The burst defaults to
+// the rate rounded up.
+func ParseLimits(s string) (Limits, error) {
+	limits := Limits{}
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		eq := strings.LastIndex(pair, "=")
+		if eq <= 0 {
+			return nil, fmt.Errorf("invalid limit %q, expected method=rate:burst", pair)
+		}
+		method, value := strings.TrimSpace(pair[:eq]), pair[eq+1:]
+
+		limit, err := parseLimit(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid limit of %s: %w", method, err)
+		}
+		limits[method] = limit
+	}
+
+	return limits, nil
+}
+
+func parseLimit(s string) (Limit, error) {
+	rateValue, burstValue := s, ""
+	if i := strings.Index(s, ":"); i >= 0 {
+		rateValue, burstValue = s[:i], s[i+1:]
+	}
+
+	r, err := strconv.ParseFloat(strings.TrimSpace(rateValue), 64)
+	if err != nil || r < 0 {
+		return Limit{}, fmt.Errorf("invalid rate %q", rateValue)
+	}
+
+	burst := int(r)
+	if float64(burst) < r {
+		burst++
+	}
+	if burstValue != "" {
+		if burst, err = strconv.Atoi(strings.TrimSpace(burstValue)); err != nil || burst < 0 {
+			return Limit{}, fmt.Errorf("invalid burst %q", burstValue)
+		}
+	}
+
+	return Limit{Rate: r, Burst: burst}, nil
+}
+
+// Config of the limiter
+type Config struct {
+	// Default is the limit of methods not listed in Methods
+	Default Limit
+	Methods Limits
+	// MaxInFlight caps the unary requests handled at once over all clients,
+	// zero means no cap
+	MaxInFlight int
+	// GatewaySecret is sent by the HTTP gateway in GatewayHeader. Only calls
+	// carrying it are limited by the address in their x-forwarded-for, empty
+	// trusts no forwarded address.
+	GatewaySecret string
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// Limiter rate limits every client on every method with its own token
+// bucket, and caps the requests handled at once
+type Limiter struct {
+	cfg      Config
+	inFlight chan struct{}
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	sweptAt time.Time
+}
+
+// New returns a limiter enforcing cfg
+func New(cfg Config) *Limiter {
+	l := &Limiter{
+		cfg:     cfg,
+		buckets: map[string]*bucket{},
+		sweptAt: time.Now(),
+	}
+	if cfg.MaxInFlight > 0 {
+		l.inFlight = make(chan struct{}, cfg.MaxInFlight)
+	}
+
+	return l
+}
+
+// allow takes a token from the bucket of the client on the method. When
+// the bucket is empty it returns how long until a token is available.
+func (l *Limiter) allow(client, method string) (time.Duration, bool) {
+	limit := l.cfg.Methods.of(method, l.cfg.Default)
+	if limit.Rate <= 0 {
+		return 0, true
+	}
+
+	now := time.Now()
+	key := client + " " + method
+
+	l.mu.Lock()
+	l.sweep(now)
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(limit.Rate), limit.Burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+	l.mu.Unlock()
+
+	reservation := b.limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		// the burst is zero, no request is ever let through
+		return 0, false
+	}
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return delay, false
+	}
+
+	return 0, true
+}
+
+// sweep drops the buckets of clients idle for longer than idleTTL, l.mu
+// must be held
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.sweptAt) < idleTTL {
+		return
+	}
+	l.sweptAt = now
+
+	for key, b := range l.buckets {
+		if now.Sub(b.lastSeen) >= idleTTL {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// acquire takes an in-flight slot, release has to be called once the
+// request is handled
+func (l *Limiter) acquire() (release func(), ok bool) {
+	if l.inFlight == nil {
+		return func() {}, true
+	}
+
+	select {
+	case l.inFlight <- struct{}{}:
+		return func() { <-l.inFlight }, true
+	default:
+		return nil, false
+	}
+}
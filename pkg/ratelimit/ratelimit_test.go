@@ -0,0 +1,129 @@
+package ratelimit
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseLimits(t *testing.T) {
+	tests := []struct {
+		name   string
+		spec   string
+		limits Limits
+		failed bool
+	}{
+		{
+			name: "rates and bursts",
+			spec: " /catalog.CatalogService/ListBook=5:10, /catalog.CatalogService/=1.5 ,",
+			limits: Limits{
+				"/catalog.CatalogService/ListBook": {Rate: 5, Burst: 10},
+				"/catalog.CatalogService/":         {Rate: 1.5, Burst: 2},
+			},
+		},
+		{
+			name:   "no limits",
+			spec:   "",
+			limits: Limits{},
+		},
+		{
+			name:   "zero rate",
+			spec:   "/catalog.CatalogService/ListBook=0",
+			limits: Limits{"/catalog.CatalogService/ListBook": {}},
+		},
+		{name: "no method", spec: "=5:10", failed: true},
+		{name: "no rate", spec: "/catalog.CatalogService/ListBook", failed: true},
+		{name: "rate which isn't a number", spec: "/catalog.CatalogService/ListBook=fast", failed: true},
+		{name: "negative rate", spec: "/catalog.CatalogService/ListBook=-1", failed: true},
+		{name: "burst which isn't a number", spec: "/catalog.CatalogService/ListBook=5:many", failed: true},
+		{name: "negative burst", spec: "/catalog.CatalogService/ListBook=5:-1", failed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limits, err := ParseLimits(tt.spec)
+			if tt.failed {
+				if err == nil {
+					t.Fatalf("got limits %v, want an error", limits)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(limits, tt.limits) {
+				t.Fatalf("got limits %v, want %v", limits, tt.limits)
+			}
+		})
+	}
+}
+
+func TestLimitsOf(t *testing.T) {
+	limits := Limits{
+		"/catalog.CatalogService/ListBook": {Rate: 5, Burst: 10},
+		"/catalog.CatalogService/":         {Rate: 1, Burst: 1},
+	}
+	fallback := Limit{Rate: 100, Burst: 100}
+
+	tests := map[string]Limit{
+		"/catalog.CatalogService/ListBook": {Rate: 5, Burst: 10},
+		"/catalog.CatalogService/GetBook":  {Rate: 1, Burst: 1},
+		"/catalog.OtherService/GetBook":    fallback,
+	}
+	for method, want := range tests {
+		if got := limits.of(method, fallback); got != want {
+			t.Fatalf("got limit %v of %s, want %v", got, method, want)
+		}
+	}
+}
+
+func TestAllowRefillsBuckets(t *testing.T) {
+	l := New(Config{Default: Limit{Rate: 50, Burst: 2}})
+	const method = "/catalog.CatalogService/GetBook"
+
+	for i := 0; i < 2; i++ {
+		if _, ok := l.allow("addr:10.0.0.1", method); !ok {
+			t.Fatalf("request %d of the burst was limited", i+1)
+		}
+	}
+
+	delay, ok := l.allow("addr:10.0.0.1", method)
+	if ok {
+		t.Fatal("request over the burst was let through")
+	}
+	if delay <= 0 || delay > 20*time.Millisecond {
+		t.Fatalf("got delay %s, want up to the 20ms a token takes", delay)
+	}
+
+	// other clients and methods have buckets of their own
+	if _, ok = l.allow("addr:10.0.0.2", method); !ok {
+		t.Fatal("another client was limited")
+	}
+	if _, ok = l.allow("addr:10.0.0.1", "/catalog.CatalogService/ListBook"); !ok {
+		t.Fatal("another method was limited")
+	}
+
+	time.Sleep(delay)
+	if _, ok = l.allow("addr:10.0.0.1", method); !ok {
+		t.Fatal("request after the bucket refilled was limited")
+	}
+}
+
+func TestAllowWithoutLimit(t *testing.T) {
+	l := New(Config{
+		Default: Limit{Rate: 1, Burst: 0},
+		Methods: Limits{"/catalog.CatalogService/GetBook": {}},
+	})
+
+	for i := 0; i < 10; i++ {
+		if _, ok := l.allow("addr:10.0.0.1", "/catalog.CatalogService/GetBook"); !ok {
+			t.Fatal("method without a rate was limited")
+		}
+	}
+
+	// a zero burst lets nothing through
+	if delay, ok := l.allow("addr:10.0.0.1", "/catalog.CatalogService/ListBook"); ok || delay != 0 {
+		t.Fatalf("got delay %s and allowed %t, want the request rejected", delay, ok)
+	}
+}
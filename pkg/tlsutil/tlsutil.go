@@ -0,0 +1,201 @@
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/abdullohsattorov/catalog-service/pkg/logger"
+)
+
+// ServerOptions configure the TLS of a server. Setting ClientCAFile turns
+// on mutual TLS: clients have to present a certificate signed by one of its
+// CAs.
+type ServerOptions struct {
+	CertFile     string
+	KeyFile      string
+	ClientCAFile string
+}
+
+// ClientOptions configure the TLS of connections to other services. The
+// system roots are trusted unless CAFile is set, CertFile and KeyFile are
+// presented to servers requiring client certificates.
+type ClientOptions struct {
+	CAFile   string
+	CertFile string
+	KeyFile  string
+	// ServerName overrides the name the server certificate is checked for
+	ServerName string
+}
+
+// ServerConfig returns the TLS config of a server. The certificate is
+// reloaded when its files change, so renewed certificates are picked up
+// without a restart.
+func ServerConfig(opts ServerOptions, log logger.Logger) (*tls.Config, error) {
+	cert, err := NewCertReloader(opts.CertFile, opts.KeyFile, log)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: cert.GetCertificate,
+	}
+
+	if opts.ClientCAFile != "" {
+		if cfg.ClientCAs, err = certPool(opts.ClientCAFile); err != nil {
+			return nil, err
+		}
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return cfg, nil
+}
+
+// ClientConfig returns the TLS config of connections to other services
+func ClientConfig(opts ClientOptions, log logger.Logger) (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: opts.ServerName,
+	}
+
+	if opts.CAFile != "" {
+		pool, err := certPool(opts.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		cfg.RootCAs = pool
+	}
+
+	if opts.CertFile != "" || opts.KeyFile != "" {
+		cert, err := NewCertReloader(opts.CertFile, opts.KeyFile, log)
+		if err != nil {
+			return nil, err
+		}
+		cfg.GetClientCertificate = cert.GetClientCertificate
+	}
+
+	return cfg, nil
+}
+
+func certPool(path string) (*x509.CertPool, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("%s has no PEM certificates", path)
+	}
+
+	return pool, nil
+}
+
+// reloadCheckInterval is how often the certificate files are checked for
+// changes, at most
+const reloadCheckInterval = 10 * time.Second
+
+// CertReloader keeps a certificate loaded from files up to date. The files
+// are checked during handshakes, if they can't be loaded the certificate
+// loaded last is kept.
+type CertReloader struct {
+	certFile, keyFile string
+	log               logger.Logger
+
+	mu        sync.Mutex
+	cert      *tls.Certificate
+	modTime   time.Time
+	checkedAt time.Time
+}
+
+// NewCertReloader loads the certificate, which has to be valid at first
+func NewCertReloader(certFile, keyFile string, log logger.Logger) (*CertReloader, error) {
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("both a certificate and a key file are required")
+	}
+
+	r := &CertReloader{certFile: certFile, keyFile: keyFile, log: log}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// GetCertificate is tls.Config.GetCertificate
+func (r *CertReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return r.certificate(), nil
+}
+
+// GetClientCertificate is tls.Config.GetClientCertificate
+func (r *CertReloader) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return r.certificate(), nil
+}
+
+func (r *CertReloader) certificate() *tls.Certificate {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Since(r.checkedAt) < reloadCheckInterval {
+		return r.cert
+	}
+	r.checkedAt = time.Now()
+
+	if modTime, err := r.lastModified(); err != nil || !modTime.After(r.modTime) {
+		return r.cert
+	}
+
+	if err := r.loadLocked(); err != nil {
+		r.log.Error("failed to reload tls certificate, keeping the previous one",
+			logger.Error(err), logger.String("cert_file", r.certFile))
+		return r.cert
+	}
+	r.log.Info("tls certificate reloaded", logger.String("cert_file", r.certFile))
+
+	return r.cert
+}
+
+func (r *CertReloader) load() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.checkedAt = time.Now()
+	return r.loadLocked()
+}
+
+func (r *CertReloader) loadLocked() error {
+	modTime, err := r.lastModified()
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+
+	r.cert, r.modTime = &cert, modTime
+	return nil
+}
+
+// lastModified returns when the certificate or the key was changed last
+func (r *CertReloader) lastModified() (time.Time, error) {
+	var last time.Time
+	for _, path := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(path)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if info.ModTime().After(last) {
+			last = info.ModTime()
+		}
+	}
+
+	return last, nil
+}
@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalidISBN = errors.New("invalid ISBN")
+
+// NormalizeISBN validates an ISBN-10 or ISBN-13, with or without hyphens and
+// spaces, and returns it as ISBN-13 digits. An empty string stays empty.
+func NormalizeISBN(s string) (string, error) {
+	isbn := strings.ToUpper(strings.NewReplacer("-", "", " ", "").Replace(s))
+
+	switch len(isbn) {
+	case 0:
+		return "", nil
+	case 10:
+		if !validISBN10(isbn) {
+			return "", ErrInvalidISBN
+		}
+		isbn = "978" + isbn[:9]
+		return isbn + string(isbn13CheckDigit(isbn)), nil
+	case 13:
+		if !digitsOnly(isbn) || isbn13CheckDigit(isbn[:12]) != isbn[12] {
+			return "", ErrInvalidISBN
+		}
+		return isbn, nil
+	default:
+		return "", ErrInvalidISBN
+	}
+}
+
+func validISBN10(isbn string) bool {
+	if !digitsOnly(isbn[:9]) {
+		return false
+	}
+
+	sum := 0
+	for i := 0; i < 9; i++ {
+		sum += int(isbn[i]-'0') * (10 - i)
+	}
+
+	switch c := isbn[9]; {
+	case c == 'X':
+		sum += 10
+	case c >= '0' && c <= '9':
+		sum += int(c - '0')
+	default:
+		return false
+	}
+
+	return sum%11 == 0
+}
+
+// isbn13CheckDigit computes the check digit of the first 12 digits
+func isbn13CheckDigit(isbn string) byte {
+	sum := 0
+	for i := 0; i < 12; i++ {
+		d := int(isbn[i] - '0')
+		if i%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+
+	return byte('0' + (10-sum%10)%10)
+}
+
+func digitsOnly(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
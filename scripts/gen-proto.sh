@@ -9,6 +9,14 @@ for module in $(find $CURRENT_DIR/book-store-protos/* -type d); do
             $module/*.proto;
 done;
 
+# HTTP/JSON gateway and OpenAPI spec, their routes are set by the http
+# rules of api/ instead of annotations in the protos
+protoc -I /usr/local/include \
+       -I $CURRENT_DIR/book-store-protos/ \
+        --grpc-gateway_out=logtostderr=true,grpc_api_configuration=$CURRENT_DIR/api/catalog_service/catalog_http.yaml:$CURRENT_DIR/genproto/ \
+        --swagger_out=logtostderr=true,grpc_api_configuration=$CURRENT_DIR/api/catalog_service/catalog_http.yaml:$CURRENT_DIR/api/ \
+        $CURRENT_DIR/book-store-protos/catalog_service/catalog.proto;
+
 for module in $(find $CURRENT_DIR/genproto/* -type d); do
   if [[ "$OSTYPE" == "darwin"* ]]; then
     sed -i "" -e "s/,omitempty//g" $module/*.go
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	"github.com/abdullohsattorov/catalog-service/pkg/events"
+	l "github.com/abdullohsattorov/catalog-service/pkg/logger"
+)
+
+func (s *CatalogService) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsReq) (*pb.ListRespAuditEvent, error) {
+	switch req.EntityType {
+	case "", events.AggregateBook, events.AggregateAuthor, events.AggregateCategory:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown entity type %q", req.EntityType)
+	}
+
+	if req.EntityId != "" {
+		id, ok := canonicalID(req.EntityId)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid entity id %q, expected a uuid", req.EntityId)
+		}
+		req.EntityId = id
+	}
+
+	// created_at is stored in UTC without a time zone
+	for _, ts := range []*string{&req.From, &req.To} {
+		if *ts == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, *ts)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid timestamp %q, expected RFC 3339", *ts)
+		}
+		*ts = t.UTC().Format("2006-01-02 15:04:05.999999")
+	}
+
+	auditEvents, count, err := s.storage.Audit().ListAuditEvents(ctx, *req)
+	if err != nil {
+		s.log(ctx).Error("failed to list audit events", l.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list audit events")
+	}
+
+	return &pb.ListRespAuditEvent{
+		AuditEvents: auditEvents,
+		Count:       count,
+	}, nil
+}
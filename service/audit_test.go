@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	l "github.com/abdullohsattorov/catalog-service/pkg/logger"
+)
+
+// recordedAuditQuery keeps the query it's asked to list events for
+type recordedAuditQuery struct {
+	req *pb.ListAuditEventsReq
+}
+
+func (r *recordedAuditQuery) ListAuditEvents(ctx context.Context, req pb.ListAuditEventsReq) ([]*pb.AuditEvent, int64, error) {
+	r.req = &req
+	return nil, 0, nil
+}
+
+func TestListAuditEventsEntityID(t *testing.T) {
+	tests := []struct {
+		name     string
+		entityID string
+		query    string
+		code     codes.Code
+	}{
+		{
+			name: "no entity id",
+		},
+		{
+			name:     "entity id in upper case",
+			entityID: "6F1C1C1E-8D4B-4C57-9A54-1B1F0F3F0A01",
+			query:    "6f1c1c1e-8d4b-4c57-9a54-1b1f0f3f0a01",
+		},
+		{
+			name:     "entity id which isn't a uuid",
+			entityID: "b1",
+			code:     codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			audit := &recordedAuditQuery{}
+			s := &CatalogService{
+				storage: fakeStorage{audit: audit},
+				logger:  l.New(l.LevelError, "test"),
+			}
+
+			_, err := s.ListAuditEvents(context.Background(), &pb.ListAuditEventsReq{EntityId: tt.entityID})
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("got code %s (%v), want %s", code, err, tt.code)
+			}
+			if err != nil {
+				if audit.req != nil {
+					t.Fatal("invalid query reached storage")
+				}
+				return
+			}
+			if audit.req.EntityId != tt.query {
+				t.Fatalf("got entity id %q in the query, want %q", audit.req.EntityId, tt.query)
+			}
+		})
+	}
+}
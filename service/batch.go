@@ -0,0 +1,231 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/gofrs/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	l "github.com/abdullohsattorov/catalog-service/pkg/logger"
+	"github.com/abdullohsattorov/catalog-service/storage/repo"
+)
+
+// maxBatchSize limits the number of items of a single batch request
+const maxBatchSize = 100
+
+func checkBatchSize(n int) error {
+	if n == 0 {
+		return status.Error(codes.InvalidArgument, "batch is empty")
+	}
+	if n > maxBatchSize {
+		return status.Errorf(codes.InvalidArgument, "batch has %d items, at most %d are allowed", n, maxBatchSize)
+	}
+
+	return nil
+}
+
+// batchIDs returns the ids which are uuids in their canonical form, the ones
+// which aren't can't belong to anything and are reported missing
+func batchIDs(ids []string) []string {
+	valid := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if canonical, ok := canonicalID(id); ok {
+			valid = append(valid, canonical)
+		}
+	}
+
+	return valid
+}
+
+func canonicalID(id string) (string, bool) {
+	u, err := uuid.FromString(id)
+	if err != nil {
+		return "", false
+	}
+
+	return u.String(), true
+}
+
+func (s *CatalogService) BatchGetBooks(ctx context.Context, req *pb.BatchGetReq) (*pb.BatchGetBooksResp, error) {
+	if err := checkBatchSize(len(req.Ids)); err != nil {
+		return nil, err
+	}
+
+	books, err := s.storage.Book().BatchGetBooks(ctx, batchIDs(req.Ids))
+	if err != nil {
+		s.log(ctx).Error("failed to batch get books", l.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get books")
+	}
+
+	byID := make(map[string]*pb.Book, len(books))
+	for _, book := range books {
+		byID[book.BookId] = book
+	}
+
+	resp := &pb.BatchGetBooksResp{}
+	for _, id := range req.Ids {
+		canonical, _ := canonicalID(id)
+		if book, ok := byID[canonical]; ok {
+			resp.Books = append(resp.Books, book)
+		} else {
+			resp.MissingIds = append(resp.MissingIds, id)
+		}
+	}
+
+	s.attachRatings(ctx, books...)
+
+	return resp, nil
+}
+
+func (s *CatalogService) BatchGetAuthors(ctx context.Context, req *pb.BatchGetReq) (*pb.BatchGetAuthorsResp, error) {
+	if err := checkBatchSize(len(req.Ids)); err != nil {
+		return nil, err
+	}
+
+	authors, err := s.storage.Author().BatchGetAuthors(ctx, batchIDs(req.Ids))
+	if err != nil {
+		s.log(ctx).Error("failed to batch get authors", l.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get authors")
+	}
+
+	byID := make(map[string]*pb.Author, len(authors))
+	for _, author := range authors {
+		byID[author.AuthorId] = author
+	}
+
+	resp := &pb.BatchGetAuthorsResp{}
+	for _, id := range req.Ids {
+		canonical, _ := canonicalID(id)
+		if author, ok := byID[canonical]; ok {
+			resp.Authors = append(resp.Authors, author)
+		} else {
+			resp.MissingIds = append(resp.MissingIds, id)
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *CatalogService) BatchGetCategories(ctx context.Context, req *pb.BatchGetReq) (*pb.BatchGetCategoriesResp, error) {
+	if err := checkBatchSize(len(req.Ids)); err != nil {
+		return nil, err
+	}
+
+	categories, err := s.storage.Category().BatchGetCategories(ctx, batchIDs(req.Ids))
+	if err != nil {
+		s.log(ctx).Error("failed to batch get categories", l.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get categories")
+	}
+
+	byID := make(map[string]*pb.Category, len(categories))
+	for _, category := range categories {
+		byID[category.CategoryId] = category
+	}
+
+	resp := &pb.BatchGetCategoriesResp{}
+	for _, id := range req.Ids {
+		canonical, _ := canonicalID(id)
+		if category, ok := byID[canonical]; ok {
+			resp.Categories = append(resp.Categories, category)
+		} else {
+			resp.MissingIds = append(resp.MissingIds, id)
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *CatalogService) BatchCreateBooks(ctx context.Context, req *pb.BatchCreateBooksReq) (*pb.BatchBooksResp, error) {
+	if err := checkBatchSize(len(req.Books)); err != nil {
+		return nil, err
+	}
+
+	books := make([]pb.Book, len(req.Books))
+	for i, book := range req.Books {
+		if book == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "books[%d] is empty", i)
+		}
+
+		id, err := s.entityID(ctx, book.BookId)
+		if err != nil {
+			return nil, status.Errorf(status.Code(err), "books[%d]: %s", i, status.Convert(err).Message())
+		}
+
+		books[i] = *book
+		books[i].BookId = id
+		if err = normalizeIsbn(&books[i]); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "books[%d]: %s", i, status.Convert(err).Message())
+		}
+	}
+
+	created, errs := s.storage.Book().BatchCreateBooks(ctx, books, req.AllOrNothing)
+
+	resp := &pb.BatchBooksResp{Results: make([]*pb.BookResult, len(books))}
+	for i := range books {
+		resp.Results[i] = s.bookResult(ctx, &created[i], errs[i], "create")
+	}
+
+	return resp, nil
+}
+
+func (s *CatalogService) BatchUpdateBooks(ctx context.Context, req *pb.BatchUpdateBooksReq) (*pb.BatchBooksResp, error) {
+	if err := checkBatchSize(len(req.Requests)); err != nil {
+		return nil, err
+	}
+
+	updates := make([]pb.UpdateBookReq, len(req.Requests))
+	for i, update := range req.Requests {
+		if update == nil || update.Book == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "requests[%d]: book is required", i)
+		}
+		if update.Book.Version == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "requests[%d]: version is required", i)
+		}
+
+		mask, err := bookMask(update)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "requests[%d]: %s", i, status.Convert(err).Message())
+		}
+
+		updates[i] = *update
+		updates[i].UpdateMask = mask
+		if err = normalizeIsbn(updates[i].Book); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "requests[%d]: %s", i, status.Convert(err).Message())
+		}
+	}
+
+	updated, errs := s.storage.Book().BatchUpdateBooks(ctx, updates, req.AllOrNothing)
+
+	resp := &pb.BatchBooksResp{Results: make([]*pb.BookResult, len(updates))}
+	for i := range updates {
+		resp.Results[i] = s.bookResult(ctx, &updated[i], errs[i], "update")
+	}
+
+	return resp, nil
+}
+
+// bookResult turns the outcome of one batch item into its result
+func (s *CatalogService) bookResult(ctx context.Context, book *pb.Book, err error, operation string) *pb.BookResult {
+	var st *status.Status
+	switch {
+	case err == nil:
+		return &pb.BookResult{Book: book, Code: int32(codes.OK)}
+	case errors.Is(err, repo.ErrBatchAborted):
+		st = status.New(codes.Aborted, "rolled back because another item of the batch failed")
+	case errors.Is(err, repo.ErrStaleVersion):
+		st = status.Convert(staleVersionError("book"))
+	case errors.Is(err, sql.ErrNoRows):
+		st = status.New(codes.NotFound, "book not found")
+	case errors.Is(err, repo.ErrAlreadyExists):
+		st = status.New(codes.AlreadyExists, "book with this id, isbn or external id already exists")
+	default:
+		s.log(ctx).Error("failed to "+operation+" book", l.Error(err))
+		st = status.New(codes.Internal, "failed to "+operation+" book")
+	}
+
+	return &pb.BookResult{Code: int32(st.Code()), Error: st.Message()}
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/gofrs/uuid"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	l "github.com/abdullohsattorov/catalog-service/pkg/logger"
+	"github.com/abdullohsattorov/catalog-service/storage/repo"
+)
+
+const (
+	authorID   = "6f1c1c1e-8d4b-4c57-9a54-1b1f0f3f0a01"
+	categoryID = "6f1c1c1e-8d4b-4c57-9a54-1b1f0f3f0a02"
+)
+
+// uuidColumns fails lookups of ids which aren't uuids, the way the id
+// columns of the database do
+type uuidColumns struct {
+	repo.AuthorStorageI
+	repo.CategoryStorageI
+}
+
+func checkUUIDs(ids []string) error {
+	for _, id := range ids {
+		if _, err := uuid.FromString(id); err != nil {
+			return errors.New("pq: invalid input syntax for type uuid")
+		}
+	}
+
+	return nil
+}
+
+func (uuidColumns) BatchGetAuthors(ctx context.Context, ids []string) ([]*pb.Author, error) {
+	if err := checkUUIDs(ids); err != nil {
+		return nil, err
+	}
+
+	var authors []*pb.Author
+	for _, id := range ids {
+		if id == authorID {
+			authors = append(authors, &pb.Author{AuthorId: id})
+		}
+	}
+
+	return authors, nil
+}
+
+func (uuidColumns) BatchGetCategories(ctx context.Context, ids []string) ([]*pb.Category, error) {
+	if err := checkUUIDs(ids); err != nil {
+		return nil, err
+	}
+
+	var categories []*pb.Category
+	for _, id := range ids {
+		if id == categoryID {
+			categories = append(categories, &pb.Category{CategoryId: id})
+		}
+	}
+
+	return categories, nil
+}
+
+func TestBatchGetMalformedIDs(t *testing.T) {
+	s := &CatalogService{
+		storage: fakeStorage{author: uuidColumns{}, category: uuidColumns{}},
+		logger:  l.New(l.LevelError, "test"),
+	}
+	ctx := context.Background()
+	unknown := "6f1c1c1e-8d4b-4c57-9a54-1b1f0f3f0aff"
+
+	authors, err := s.BatchGetAuthors(ctx, &pb.BatchGetReq{Ids: []string{"not-a-uuid", authorID, unknown}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(authors.Authors) != 1 || authors.Authors[0].AuthorId != authorID {
+		t.Fatalf("got authors %v, want %s", authors.Authors, authorID)
+	}
+	if want := []string{"not-a-uuid", unknown}; !reflect.DeepEqual(authors.MissingIds, want) {
+		t.Fatalf("got missing ids %v, want %v", authors.MissingIds, want)
+	}
+
+	// ids are found however their uuid is written
+	upper := "6F1C1C1E-8D4B-4C57-9A54-1B1F0F3F0A02"
+	categories, err := s.BatchGetCategories(ctx, &pb.BatchGetReq{Ids: []string{upper, "1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(categories.Categories) != 1 || categories.Categories[0].CategoryId != categoryID {
+		t.Fatalf("got categories %v, want %s", categories.Categories, categoryID)
+	}
+	if want := []string{"1"}; !reflect.DeepEqual(categories.MissingIds, want) {
+		t.Fatalf("got missing ids %v, want %v", categories.MissingIds, want)
+	}
+}
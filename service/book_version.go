@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	"github.com/abdullohsattorov/catalog-service/pkg/audit"
+	l "github.com/abdullohsattorov/catalog-service/pkg/logger"
+)
+
+func (s *CatalogService) DiffBookVersions(ctx context.Context, req *pb.DiffBookVersionsReq) (*pb.BookVersionDiff, error) {
+	if req.FromVersion <= 0 || req.ToVersion <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "both versions are required")
+	}
+
+	from, err := s.storage.Book().GetBookVersion(ctx, req.BookId, req.FromVersion)
+	if err != nil {
+		return nil, s.bookVersionError(ctx, err, req.FromVersion)
+	}
+
+	to, err := s.storage.Book().GetBookVersion(ctx, req.BookId, req.ToVersion)
+	if err != nil {
+		return nil, s.bookVersionError(ctx, err, req.ToVersion)
+	}
+
+	return &pb.BookVersionDiff{
+		BookId:      req.BookId,
+		FromVersion: req.FromVersion,
+		ToVersion:   req.ToVersion,
+		Changes:     audit.Diff(&from, &to),
+	}, nil
+}
+
+func (s *CatalogService) bookVersionError(ctx context.Context, err error, version int64) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Errorf(codes.NotFound, "version %d of book not found", version)
+	}
+
+	s.log(ctx).Error("failed to get book version", l.Error(err))
+	return status.Error(codes.Internal, "failed to get book version")
+}
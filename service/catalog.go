@@ -2,86 +2,273 @@ package service
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/gofrs/uuid"
 
+	"github.com/abdullohsattorov/catalog-service/config"
 	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	"github.com/abdullohsattorov/catalog-service/pkg/events"
 	l "github.com/abdullohsattorov/catalog-service/pkg/logger"
+	"github.com/abdullohsattorov/catalog-service/pkg/utils"
+	grpcClient "github.com/abdullohsattorov/catalog-service/service/grpc_client"
 	"github.com/abdullohsattorov/catalog-service/storage"
+	"github.com/abdullohsattorov/catalog-service/storage/repo"
 )
 
 // CatalogService ...
 type CatalogService struct {
 	storage storage.IStorage
 	logger  l.Logger
+	client  grpcClient.IGrpcClient
+	ratings *ratingCache
+	hub     *events.Hub
+
+	idempotencyTTL   time.Duration
+	idempotencyLease time.Duration
+	allowClientIDs   bool
+	priceCurrency    string
 }
 
 // NewCatalogService ...
-func NewCatalogService(storage storage.IStorage, log l.Logger) *CatalogService {
+func NewCatalogService(
+	cfg config.Config, storage storage.IStorage, log l.Logger, client grpcClient.IGrpcClient, hub *events.Hub,
+) *CatalogService {
 	return &CatalogService{
 		storage: storage,
 		logger:  log,
+		client:  client,
+		ratings: newRatingCache(cfg.ReviewCacheTTL),
+		hub:     hub,
+
+		idempotencyTTL:   cfg.IdempotencyKeyTTL,
+		idempotencyLease: cfg.IdempotencyLease,
+		allowClientIDs:   cfg.AllowClientIDs,
+		priceCurrency:    cfg.PriceCurrency,
 	}
 }
 
+// log returns the logger of the request ctx belongs to
+func (s *CatalogService) log(ctx context.Context) l.Logger {
+	return l.FromContext(ctx, s.logger)
+}
+
 func (s *CatalogService) CreateBook(ctx context.Context, req *pb.Book) (*pb.Book, error) {
-	id, err := uuid.NewV4()
+	id, err := s.entityID(ctx, req.BookId)
 	if err != nil {
-		s.logger.Error("failed while generating uuid", l.Error(err))
-		return nil, status.Error(codes.Internal, "failed generate uuid")
+		return nil, err
 	}
 
-	req.BookId = id.String()
+	req.BookId = id
 
-	book, err := s.storage.Book().CreateBook(*req)
+	if err = normalizeIsbn(req); err != nil {
+		return nil, err
+	}
+
+	book, err := s.storage.Book().CreateBook(ctx, *req)
+	if errors.Is(err, repo.ErrAlreadyExists) {
+		return nil, status.Error(codes.AlreadyExists, "book with this id, isbn or external id already exists")
+	}
 	if err != nil {
-		s.logger.Error("failed to create book", l.Error(err))
+		s.log(ctx).Error("failed to create book", l.Error(err))
 		return nil, status.Error(codes.Internal, "failed to create book")
 	}
 
 	return &book, nil
 }
 
-func (s *CatalogService) GetBook(ctx context.Context, req *pb.ByIdReq) (*pb.Book, error) {
-	book, err := s.storage.Book().GetBook(req.GetId())
+func (s *CatalogService) GetBook(ctx context.Context, req *pb.GetBookReq) (*pb.Book, error) {
+	var (
+		book pb.Book
+		err  error
+	)
+
+	switch {
+	case req.Version != 0:
+		book, err = s.storage.Book().GetBookVersion(ctx, req.Id, req.Version)
+	case req.AsOf != "":
+		asOf, parseErr := time.Parse(time.RFC3339, req.AsOf)
+		if parseErr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid as_of %q, expected RFC 3339", req.AsOf)
+		}
+		book, err = s.storage.Book().GetBookAsOf(ctx, req.Id, asOf)
+	default:
+		book, err = s.storage.Book().GetBook(ctx, req.GetId())
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "book not found")
+	}
 	if err != nil {
-		s.logger.Error("failed to get book", l.Error(err))
+		s.log(ctx).Error("failed to get book", l.Error(err))
 		return nil, status.Error(codes.Internal, "failed to get book")
 	}
 
+	s.attachRatings(ctx, &book)
+	setETag(ctx, book.Version)
+
 	return &book, nil
 }
 
 func (s *CatalogService) ListBook(ctx context.Context, req *pb.ListBookReq) (*pb.ListRespBook, error) {
-	books, count, err := s.storage.Book().ListBook(req.Page, req.Limit, req.Filters)
+	byRating, ok, err := parseRatingFilter(req.Filters)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if ok {
+		return s.listBookByRating(ctx, req, byRating)
+	}
+
+	books, count, err := s.storage.Book().ListBook(ctx, req.Page, req.Limit, req.Filters)
+	if err != nil {
+		s.log(ctx).Error("failed to list books", l.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list books")
+	}
+
+	s.attachRatings(ctx, books...)
+
+	return &pb.ListRespBook{
+		Books: books,
+		Count: count,
+	}, nil
+}
+
+// listBookByRating lists every book matching the storage filters, since
+// ratings live in review_service and can't be filtered or sorted in SQL.
+// When more than maxRatingCandidates books match, the request fails rather
+// than filtering only some of them.
+func (s *CatalogService) listBookByRating(ctx context.Context, req *pb.ListBookReq, f ratingFilter) (*pb.ListRespBook, error) {
+	_, total, err := s.storage.Book().ListBook(ctx, 1, 1, req.Filters)
+	if err != nil {
+		s.log(ctx).Error("failed to list books", l.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list books")
+	}
+	if total == 0 {
+		return &pb.ListRespBook{}, nil
+	}
+	if total > maxRatingCandidates {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"%d books match, narrow the filters to at most %d to filter or sort by rating", total, maxRatingCandidates)
+	}
+
+	books, _, err := s.storage.Book().ListBook(ctx, 1, total, req.Filters)
 	if err != nil {
-		s.logger.Error("failed to list books", l.Error(err))
+		s.log(ctx).Error("failed to list books", l.Error(err))
 		return nil, status.Error(codes.Internal, "failed to list books")
 	}
 
+	ids := make([]string, 0, len(books))
+	for _, book := range books {
+		ids = append(ids, book.BookId)
+	}
+
+	ratings, err := s.bookRatings(ctx, ids)
+	if err != nil {
+		s.log(ctx).Error("failed to get book ratings", l.Error(err))
+		return nil, status.Error(codes.Unavailable, "ratings are unavailable, can't filter or sort by rating")
+	}
+
+	for _, book := range books {
+		book.AverageRating = ratings[book.BookId].AverageRating
+		book.ReviewCount = ratings[book.BookId].ReviewCount
+	}
+
+	books, count := f.apply(books, req.Page, req.Limit)
+
 	return &pb.ListRespBook{
 		Books: books,
 		Count: count,
 	}, nil
 }
 
-func (s *CatalogService) UpdateBook(ctx context.Context, req *pb.Book) (*pb.Book, error) {
-	book, err := s.storage.Book().UpdateBook(*req)
+func (s *CatalogService) UpdateBook(ctx context.Context, req *pb.UpdateBookReq) (*pb.Book, error) {
+	if req.Book == nil {
+		return nil, status.Error(codes.InvalidArgument, "book is required")
+	}
+
+	mask, err := bookMask(req)
 	if err != nil {
-		s.logger.Error("failed to update book", l.Error(err))
+		return nil, err
+	}
+	req.UpdateMask = mask
+
+	if err = normalizeIsbn(req.Book); err != nil {
+		return nil, err
+	}
+
+	version, err := expectedVersion(ctx, req.Book.Version)
+	if err != nil {
+		return nil, err
+	}
+	req.Book.Version = version
+
+	book, err := s.storage.Book().UpdateBook(ctx, *req)
+	if errors.Is(err, repo.ErrStaleVersion) {
+		return nil, staleVersionError("book")
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "book not found")
+	}
+	if errors.Is(err, repo.ErrAlreadyExists) {
+		return nil, status.Error(codes.AlreadyExists, "another book has this isbn or external id")
+	}
+	if err != nil {
+		s.log(ctx).Error("failed to update book", l.Error(err))
 		return nil, status.Error(codes.Internal, "failed to update book")
 	}
 
+	setETag(ctx, book.Version)
+
 	return &book, nil
 }
 
-func (s *CatalogService) DeleteBook(ctx context.Context, req *pb.ByIdReq) (*pb.EmptyResp, error) {
-	err := s.storage.Book().DeleteBook(req.Id)
+// entityID returns the id of a new entity. Ids sent by clients are kept
+// when it is allowed, the rest get a new one.
+func (s *CatalogService) entityID(ctx context.Context, clientID string) (string, error) {
+	if s.allowClientIDs && clientID != "" {
+		id, err := uuid.FromString(clientID)
+		if err != nil {
+			return "", status.Errorf(codes.InvalidArgument, "invalid id %q, expected a uuid", clientID)
+		}
+		return id.String(), nil
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		s.log(ctx).Error("failed while generating uuid", l.Error(err))
+		return "", status.Error(codes.Internal, "failed generate uuid")
+	}
+
+	return id.String(), nil
+}
+
+// normalizeIsbn stores ISBNs as ISBN-13 digits, so they stay unique however
+// they are written
+func normalizeIsbn(book *pb.Book) error {
+	isbn, err := utils.NormalizeISBN(book.Isbn)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid isbn %q", book.Isbn)
+	}
+	book.Isbn = isbn
+
+	return nil
+}
+
+func (s *CatalogService) DeleteBook(ctx context.Context, req *pb.DeleteBookReq) (*pb.EmptyResp, error) {
+	if err := s.checkOpenOrders(ctx, req); err != nil {
+		return nil, err
+	}
+
+	err := s.storage.Book().DeleteBook(ctx, req.Id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "book not found")
+	}
 	if err != nil {
-		s.logger.Error("failed to delete book", l.Error(err))
+		s.log(ctx).Error("failed to delete book", l.Error(err))
 		return nil, status.Error(codes.Internal, "failed to delete book")
 	}
 
@@ -89,36 +276,41 @@ func (s *CatalogService) DeleteBook(ctx context.Context, req *pb.ByIdReq) (*pb.E
 }
 
 func (s *CatalogService) CreateAuthor(ctx context.Context, req *pb.Author) (*pb.Author, error) {
-	id, err := uuid.NewV4()
+	id, err := s.entityID(ctx, req.AuthorId)
 	if err != nil {
-		s.logger.Error("failed while generating uuid", l.Error(err))
-		return nil, status.Error(codes.Internal, "failed generate uuid")
+		return nil, err
 	}
 
-	req.AuthorId = id.String()
+	req.AuthorId = id
 
-	author, err := s.storage.Author().CreateAuthor(*req)
+	author, err := s.storage.Author().CreateAuthor(ctx, *req)
+	if errors.Is(err, repo.ErrAlreadyExists) {
+		return nil, status.Error(codes.AlreadyExists, "author with this id already exists")
+	}
 	if err != nil {
-		s.logger.Error("failed to create author", l.Error(err))
+		s.log(ctx).Error("failed to create author", l.Error(err))
 		return nil, status.Error(codes.Internal, "failed to create author")
 	}
+
 	return &author, nil
 }
 
 func (s *CatalogService) GetAuthor(ctx context.Context, req *pb.ByIdReq) (*pb.Author, error) {
-	author, err := s.storage.Author().GetAuthor(req.GetId())
+	author, err := s.storage.Author().GetAuthor(ctx, req.GetId())
 	if err != nil {
-		s.logger.Error("failed to get author", l.Error(err))
+		s.log(ctx).Error("failed to get author", l.Error(err))
 		return nil, status.Error(codes.Internal, "failed to get author")
 	}
 
+	setETag(ctx, author.Version)
+
 	return &author, nil
 }
 
 func (s *CatalogService) ListAuthor(ctx context.Context, req *pb.ListReq) (*pb.ListRespAuthor, error) {
-	authors, count, err := s.storage.Author().ListAuthor(req.Page, req.Limit)
+	authors, count, err := s.storage.Author().ListAuthor(ctx, req.Page, req.Limit)
 	if err != nil {
-		s.logger.Error("failed to list authors", l.Error(err))
+		s.log(ctx).Error("failed to list authors", l.Error(err))
 		return nil, status.Error(codes.Internal, "failed to list authors")
 	}
 
@@ -128,35 +320,66 @@ func (s *CatalogService) ListAuthor(ctx context.Context, req *pb.ListReq) (*pb.L
 	}, nil
 }
 
-func (s *CatalogService) UpdateAuthor(ctx context.Context, req *pb.Author) (*pb.Author, error) {
-	author, err := s.storage.Author().UpdateAuthor(*req)
+func (s *CatalogService) UpdateAuthor(ctx context.Context, req *pb.UpdateAuthorReq) (*pb.Author, error) {
+	if req.Author == nil {
+		return nil, status.Error(codes.InvalidArgument, "author is required")
+	}
+
+	mask, err := normalizeMask(req.UpdateMask, repo.AuthorFields)
+	if err != nil {
+		return nil, err
+	}
+	req.UpdateMask = mask
+
+	version, err := expectedVersion(ctx, req.Author.Version)
 	if err != nil {
-		s.logger.Error("failed to update author", l.Error(err))
+		return nil, err
+	}
+	req.Author.Version = version
+
+	author, err := s.storage.Author().UpdateAuthor(ctx, *req)
+	if errors.Is(err, repo.ErrStaleVersion) {
+		return nil, staleVersionError("author")
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "author not found")
+	}
+	if err != nil {
+		s.log(ctx).Error("failed to update author", l.Error(err))
 		return nil, status.Error(codes.Internal, "failed to update author")
 	}
+
+	setETag(ctx, author.Version)
+
 	return &author, nil
 }
 
 func (s *CatalogService) DeleteAuthor(ctx context.Context, req *pb.ByIdReq) (*pb.EmptyResp, error) {
-	err := s.storage.Author().DeleteAuthor(req.Id)
+	err := s.storage.Author().DeleteAuthor(ctx, req.Id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "author not found")
+	}
 	if err != nil {
-		s.logger.Error("failed to delete author", l.Error(err))
+		s.log(ctx).Error("failed to delete author", l.Error(err))
 		return nil, status.Error(codes.Internal, "failed to delete author")
 	}
+
 	return &pb.EmptyResp{}, nil
 }
 
 func (s *CatalogService) CreateCategory(ctx context.Context, req *pb.Category) (*pb.Category, error) {
-	id, err := uuid.NewV4()
+	id, err := s.entityID(ctx, req.CategoryId)
 	if err != nil {
-		s.logger.Error("failed while generating uuid", l.Error(err))
-		return nil, status.Error(codes.Internal, "failed generate uuid")
+		return nil, err
 	}
 
-	req.CategoryId = id.String()
-	category, err := s.storage.Category().CreateCategory(*req)
+	req.CategoryId = id
+	category, err := s.storage.Category().CreateCategory(ctx, *req)
+	if errors.Is(err, repo.ErrAlreadyExists) {
+		return nil, status.Error(codes.AlreadyExists, "category with this id already exists")
+	}
 	if err != nil {
-		s.logger.Error("failed to create category", l.Error(err))
+		s.log(ctx).Error("failed to create category", l.Error(err))
 		return nil, status.Error(codes.Internal, "failed to create category")
 	}
 
@@ -164,18 +387,21 @@ func (s *CatalogService) CreateCategory(ctx context.Context, req *pb.Category) (
 }
 
 func (s *CatalogService) GetCategory(ctx context.Context, req *pb.ByIdReq) (*pb.Category, error) {
-	category, err := s.storage.Category().GetCategory(req.GetId())
+	category, err := s.storage.Category().GetCategory(ctx, req.GetId())
 	if err != nil {
-		s.logger.Error("failed to get category", l.Error(err))
+		s.log(ctx).Error("failed to get category", l.Error(err))
 		return nil, status.Error(codes.Internal, "failed to get category")
 	}
+
+	setETag(ctx, category.Version)
+
 	return &category, nil
 }
 
 func (s *CatalogService) ListCategory(ctx context.Context, req *pb.ListReq) (*pb.ListRespCategory, error) {
-	categories, count, err := s.storage.Category().ListCategory(req.Page, req.Limit)
+	categories, count, err := s.storage.Category().ListCategory(ctx, req.Page, req.Limit)
 	if err != nil {
-		s.logger.Error("failed to list categories", l.Error(err))
+		s.log(ctx).Error("failed to list categories", l.Error(err))
 		return nil, status.Error(codes.Internal, "failed to list categories")
 	}
 
@@ -185,19 +411,47 @@ func (s *CatalogService) ListCategory(ctx context.Context, req *pb.ListReq) (*pb
 	}, nil
 }
 
-func (s *CatalogService) UpdateCategory(ctx context.Context, req *pb.Category) (*pb.Category, error) {
-	category, err := s.storage.Category().UpdateCategory(*req)
+func (s *CatalogService) UpdateCategory(ctx context.Context, req *pb.UpdateCategoryReq) (*pb.Category, error) {
+	if req.Category == nil {
+		return nil, status.Error(codes.InvalidArgument, "category is required")
+	}
+
+	mask, err := normalizeMask(req.UpdateMask, repo.CategoryFields)
+	if err != nil {
+		return nil, err
+	}
+	req.UpdateMask = mask
+
+	version, err := expectedVersion(ctx, req.Category.Version)
+	if err != nil {
+		return nil, err
+	}
+	req.Category.Version = version
+
+	category, err := s.storage.Category().UpdateCategory(ctx, *req)
+	if errors.Is(err, repo.ErrStaleVersion) {
+		return nil, staleVersionError("category")
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "category not found")
+	}
 	if err != nil {
-		s.logger.Error("failed to update category", l.Error(err))
+		s.log(ctx).Error("failed to update category", l.Error(err))
 		return nil, status.Error(codes.Internal, "failed to update category")
 	}
+
+	setETag(ctx, category.Version)
+
 	return &category, nil
 }
 
 func (s *CatalogService) DeleteCategory(ctx context.Context, req *pb.ByIdReq) (*pb.EmptyResp, error) {
-	err := s.storage.Category().DeleteCategory(req.Id)
+	err := s.storage.Category().DeleteCategory(ctx, req.Id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Error(codes.NotFound, "category not found")
+	}
 	if err != nil {
-		s.logger.Error("failed to delete category", l.Error(err))
+		s.log(ctx).Error("failed to delete category", l.Error(err))
 		return nil, status.Error(codes.Internal, "failed to delete category")
 	}
 
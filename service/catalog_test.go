@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	l "github.com/abdullohsattorov/catalog-service/pkg/logger"
+	"github.com/abdullohsattorov/catalog-service/storage"
+	"github.com/abdullohsattorov/catalog-service/storage/repo"
+)
+
+// fakeStorage serves the repositories a test needs, calling any other
+// panics
+type fakeStorage struct {
+	storage.IStorage
+	book        repo.BookStorageI
+	author      repo.AuthorStorageI
+	category    repo.CategoryStorageI
+	idempotency repo.IdempotencyStorageI
+	audit       repo.AuditStorageI
+}
+
+func (f fakeStorage) Book() repo.BookStorageI {
+	return f.book
+}
+
+func (f fakeStorage) Author() repo.AuthorStorageI {
+	return f.author
+}
+
+func (f fakeStorage) Category() repo.CategoryStorageI {
+	return f.category
+}
+
+func (f fakeStorage) Idempotency() repo.IdempotencyStorageI {
+	return f.idempotency
+}
+
+func (f fakeStorage) Audit() repo.AuditStorageI {
+	return f.audit
+}
+
+// missingRows fails every write of a row the way storage does when the row
+// doesn't exist or was deleted
+type missingRows struct {
+	repo.BookStorageI
+	repo.AuthorStorageI
+	repo.CategoryStorageI
+}
+
+func (missingRows) DeleteBook(ctx context.Context, id string) error {
+	return sql.ErrNoRows
+}
+
+func (missingRows) DeleteAuthor(ctx context.Context, id string) error {
+	return sql.ErrNoRows
+}
+
+func (missingRows) DeleteCategory(ctx context.Context, id string) error {
+	return sql.ErrNoRows
+}
+
+func (missingRows) UpdateBook(ctx context.Context, update pb.UpdateBookReq) (pb.Book, error) {
+	return pb.Book{}, sql.ErrNoRows
+}
+
+func (missingRows) UpdateAuthor(ctx context.Context, update pb.UpdateAuthorReq) (pb.Author, error) {
+	return pb.Author{}, sql.ErrNoRows
+}
+
+func (missingRows) UpdateCategory(ctx context.Context, update pb.UpdateCategoryReq) (pb.Category, error) {
+	return pb.Category{}, sql.ErrNoRows
+}
+
+func newMissingRowsTestService() *CatalogService {
+	return &CatalogService{
+		storage: fakeStorage{book: missingRows{}, author: missingRows{}, category: missingRows{}},
+		logger:  l.New(l.LevelError, "test"),
+	}
+}
+
+func TestDeleteMissing(t *testing.T) {
+	s := newMissingRowsTestService()
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		delete func() error
+	}{
+		{
+			name: "book",
+			delete: func() error {
+				_, err := s.DeleteBook(ctx, &pb.DeleteBookReq{Id: "b1", Force: true})
+				return err
+			},
+		},
+		{
+			name: "author",
+			delete: func() error {
+				_, err := s.DeleteAuthor(ctx, &pb.ByIdReq{Id: "a1"})
+				return err
+			},
+		},
+		{
+			name: "category",
+			delete: func() error {
+				_, err := s.DeleteCategory(ctx, &pb.ByIdReq{Id: "c1"})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := status.Code(tt.delete()); code != codes.NotFound {
+				t.Fatalf("got code %s, want %s", code, codes.NotFound)
+			}
+		})
+	}
+}
+
+func TestUpdateMissing(t *testing.T) {
+	s := newMissingRowsTestService()
+	ctx := context.Background()
+
+	tests := []struct {
+		name   string
+		update func() error
+	}{
+		{
+			name: "book",
+			update: func() error {
+				_, err := s.UpdateBook(ctx, &pb.UpdateBookReq{Book: &pb.Book{BookId: "b1", Version: 1}})
+				return err
+			},
+		},
+		{
+			name: "author",
+			update: func() error {
+				_, err := s.UpdateAuthor(ctx, &pb.UpdateAuthorReq{Author: &pb.Author{AuthorId: "a1", Version: 1}})
+				return err
+			},
+		},
+		{
+			name: "category",
+			update: func() error {
+				_, err := s.UpdateCategory(ctx, &pb.UpdateCategoryReq{Category: &pb.Category{CategoryId: "c1", Version: 1}})
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := status.Code(tt.update()); code != codes.NotFound {
+				t.Fatalf("got code %s, want %s", code, codes.NotFound)
+			}
+		})
+	}
+}
+
+// staleBooks has every book changed since the version updates are based on
+type staleBooks struct {
+	repo.BookStorageI
+}
+
+func (staleBooks) UpdateBook(ctx context.Context, update pb.UpdateBookReq) (pb.Book, error) {
+	return pb.Book{}, repo.ErrStaleVersion
+}
+
+func TestUpdateStale(t *testing.T) {
+	s := &CatalogService{
+		storage: fakeStorage{book: staleBooks{}},
+		logger:  l.New(l.LevelError, "test"),
+	}
+
+	_, err := s.UpdateBook(context.Background(), &pb.UpdateBookReq{Book: &pb.Book{BookId: "b1", Version: 1}})
+	if code := status.Code(err); code != codes.Aborted {
+		t.Fatalf("got code %s (%v), want %s", code, err, codes.Aborted)
+	}
+}
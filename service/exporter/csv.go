@@ -0,0 +1,111 @@
+package exporter
+
+import (
+	"context"
+	"encoding/csv"
+	"io"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	"github.com/abdullohsattorov/catalog-service/service/importer"
+	"github.com/abdullohsattorov/catalog-service/storage"
+)
+
+// pageSize is how many books are read from the storage at once
+const pageSize = 500
+
+// Exporter writes the whole catalog in the formats importer reads
+type Exporter struct {
+	storage storage.IStorage
+	// currency of the prices, which MARC records name
+	currency string
+	authors  map[string]string
+}
+
+// New ...
+func New(storage storage.IStorage, currency string) *Exporter {
+	return &Exporter{storage: storage, currency: currency}
+}
+
+// WriteCSV writes every book as a row of a CSV file, the file can be
+// imported back. The writer is flushed after each page of books.
+func (e *Exporter) WriteCSV(ctx context.Context, w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(importer.Header); err != nil {
+		return err
+	}
+
+	err := e.eachPage(ctx, func(books []*pb.Book) error {
+		for _, book := range books {
+			categories := make([]string, 0, len(book.Categories))
+			for _, category := range book.Categories {
+				categories = append(categories, category.Name)
+			}
+
+			err := writer.Write([]string{
+				book.Isbn,
+				book.ExternalId,
+				book.Name,
+				e.authors[book.AuthorId],
+				cast.ToString(book.Price),
+				strings.Join(categories, importer.CategorySeparator),
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		writer.Flush()
+		return writer.Error()
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// eachPage calls fn with every page of books, ordered by id
+func (e *Exporter) eachPage(ctx context.Context, fn func(books []*pb.Book) error) error {
+	if err := e.loadAuthors(ctx); err != nil {
+		return err
+	}
+
+	var afterID string
+	for {
+		books, err := e.storage.Book().ScanBooks(ctx, afterID, pageSize)
+		if err != nil {
+			return err
+		}
+		if len(books) == 0 {
+			return nil
+		}
+
+		if err = fn(books); err != nil {
+			return err
+		}
+		afterID = books[len(books)-1].BookId
+	}
+}
+
+// loadAuthors indexes author names by id
+func (e *Exporter) loadAuthors(ctx context.Context) error {
+	_, count, err := e.storage.Author().ListAuthor(ctx, 1, 1)
+	if err != nil {
+		return err
+	}
+	authors, _, err := e.storage.Author().ListAuthor(ctx, 1, count)
+	if err != nil {
+		return err
+	}
+
+	e.authors = make(map[string]string, len(authors))
+	for _, author := range authors {
+		e.authors[author.AuthorId] = author.Name
+	}
+
+	return nil
+}
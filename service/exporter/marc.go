@@ -0,0 +1,287 @@
+package exporter
+
+import (
+	"bufio"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+)
+
+// MARC formats
+const (
+	FormatMarc21  = "marc21"
+	FormatMarcXML = "marcxml"
+)
+
+// MARC 21 record structure characters
+const (
+	marcSubfieldDelimiter = 0x1f
+	marcFieldTerminator   = 0x1e
+	marcRecordTerminator  = 0x1d
+
+	marcLeaderLength = 24
+)
+
+const marcXMLNamespace = "http://www.loc.gov/MARC21/slim"
+
+// timestamps of catalog entities as they are scanned from postgres
+var timestampLayouts = []string{time.RFC3339Nano, "2006-01-02 15:04:05.999999", "2006-01-02T15:04:05.999999"}
+
+type marcSubfield struct {
+	code  byte
+	value string
+}
+
+type marcField struct {
+	tag        string
+	ind1, ind2 byte
+	// value is set for control fields, subfields for data fields
+	value     string
+	subfields []marcSubfield
+}
+
+func (f marcField) control() bool {
+	return f.tag < "010"
+}
+
+type marcRecord struct {
+	fields []marcField
+}
+
+func (r *marcRecord) addControl(tag, value string) {
+	r.fields = append(r.fields, marcField{tag: tag, value: value})
+}
+
+func (r *marcRecord) addData(tag string, ind1, ind2 byte, subfields ...marcSubfield) {
+	r.fields = append(r.fields, marcField{tag: tag, ind1: ind1, ind2: ind2, subfields: subfields})
+}
+
+// marcBook maps a book to a bibliographic record. The id goes to 001, the
+// ISBN to 020, the external id to 024, the author to 100, the title to 245,
+// the price and its currency to 365 and every category to a 650 topical term.
+func marcBook(book *pb.Book, author, currency string) marcRecord {
+	var r marcRecord
+
+	r.addControl("001", book.BookId)
+	if updated, ok := parseTimestamp(book.UpdatedAt); ok {
+		r.addControl("005", updated.Format("20060102150405.0"))
+	}
+
+	// only the date entered is coded, the other positions hold the fill character
+	entered := strings.Repeat("|", 6)
+	if created, ok := parseTimestamp(book.CreatedAt); ok {
+		entered = created.Format("060102")
+	}
+	r.addControl("008", entered+strings.Repeat("|", 34))
+
+	if book.Isbn != "" {
+		r.addData("020", ' ', ' ', marcSubfield{'a', book.Isbn})
+	}
+	if book.ExternalId != "" {
+		r.addData("024", '8', ' ', marcSubfield{'a', book.ExternalId})
+	}
+
+	titleInd1 := byte('0')
+	if author != "" {
+		r.addData("100", personalNameType(author), ' ', marcSubfield{'a', author})
+		titleInd1 = '1'
+	}
+	r.addData("245", titleInd1, '0', marcSubfield{'a', book.Name})
+
+	if book.Price > 0 {
+		r.addData("365", ' ', ' ',
+			marcSubfield{'b', strconv.FormatFloat(float64(book.Price), 'f', 2, 32)},
+			marcSubfield{'c', currency},
+		)
+	}
+
+	for _, category := range book.Categories {
+		r.addData("650", ' ', '4', marcSubfield{'a', category.Name})
+	}
+
+	return r
+}
+
+// personalNameType is the first indicator of a personal name: 1 for a name
+// inverted to surname first, "Tolstoy, Leo", 0 for one in direct order
+func personalNameType(name string) byte {
+	if strings.Contains(name, ",") {
+		return '1'
+	}
+
+	return '0'
+}
+
+// marcLeader returns the leader of a new, complete, single item record of
+// language material in UCS/Unicode
+func marcLeader(recordLength, baseAddress int) string {
+	return fmt.Sprintf("%05dnam a22%05d   4500", recordLength, baseAddress)
+}
+
+// encodeMarc21 encodes the record in the ISO 2709 exchange format
+func encodeMarc21(r marcRecord) []byte {
+	var directory, data strings.Builder
+	for _, field := range r.fields {
+		start := data.Len()
+		if field.control() {
+			data.WriteString(field.value)
+		} else {
+			data.WriteByte(field.ind1)
+			data.WriteByte(field.ind2)
+			for _, subfield := range field.subfields {
+				data.WriteByte(marcSubfieldDelimiter)
+				data.WriteByte(subfield.code)
+				data.WriteString(subfield.value)
+			}
+		}
+		data.WriteByte(marcFieldTerminator)
+
+		fmt.Fprintf(&directory, "%s%04d%05d", field.tag, data.Len()-start, start)
+	}
+	directory.WriteByte(marcFieldTerminator)
+
+	baseAddress := marcLeaderLength + directory.Len()
+	recordLength := baseAddress + data.Len() + 1
+
+	record := make([]byte, 0, recordLength)
+	record = append(record, marcLeader(recordLength, baseAddress)...)
+	record = append(record, directory.String()...)
+	record = append(record, data.String()...)
+	record = append(record, marcRecordTerminator)
+
+	return record
+}
+
+type marcXMLSubfield struct {
+	Code  string `xml:"code,attr"`
+	Value string `xml:",chardata"`
+}
+
+type marcXMLControlField struct {
+	Tag   string `xml:"tag,attr"`
+	Value string `xml:",chardata"`
+}
+
+type marcXMLDataField struct {
+	Tag       string            `xml:"tag,attr"`
+	Ind1      string            `xml:"ind1,attr"`
+	Ind2      string            `xml:"ind2,attr"`
+	Subfields []marcXMLSubfield `xml:"subfield"`
+}
+
+type marcXMLRecord struct {
+	XMLName       xml.Name              `xml:"record"`
+	Leader        string                `xml:"leader"`
+	ControlFields []marcXMLControlField `xml:"controlfield"`
+	DataFields    []marcXMLDataField    `xml:"datafield"`
+}
+
+func marcXML(r marcRecord) marcXMLRecord {
+	// MARCXML keeps the leader but its lengths aren't meaningful
+	record := marcXMLRecord{Leader: marcLeader(0, 0)}
+	for _, field := range r.fields {
+		if field.control() {
+			record.ControlFields = append(record.ControlFields, marcXMLControlField{Tag: field.tag, Value: field.value})
+			continue
+		}
+
+		dataField := marcXMLDataField{Tag: field.tag, Ind1: string(field.ind1), Ind2: string(field.ind2)}
+		for _, subfield := range field.subfields {
+			dataField.Subfields = append(dataField.Subfields, marcXMLSubfield{Code: string(subfield.code), Value: subfield.value})
+		}
+		record.DataFields = append(record.DataFields, dataField)
+	}
+
+	return record
+}
+
+// WriteMarc writes every book as a MARC 21 bibliographic record, either in
+// the binary exchange format or as a MARCXML collection
+func (e *Exporter) WriteMarc(ctx context.Context, w io.Writer, format string) error {
+	switch format {
+	case FormatMarc21:
+		return e.writeMarc21(ctx, w)
+	case FormatMarcXML:
+		return e.writeMarcXML(ctx, w)
+	default:
+		return fmt.Errorf("unknown MARC format %q", format)
+	}
+}
+
+func (e *Exporter) writeMarc21(ctx context.Context, w io.Writer) error {
+	buf := bufio.NewWriter(w)
+
+	err := e.eachPage(ctx, func(books []*pb.Book) error {
+		for _, book := range books {
+			if _, err := buf.Write(encodeMarc21(marcBook(book, e.authors[book.AuthorId], e.currency))); err != nil {
+				return err
+			}
+		}
+
+		return buf.Flush()
+	})
+	if err != nil {
+		return err
+	}
+
+	return buf.Flush()
+}
+
+func (e *Exporter) writeMarcXML(ctx context.Context, w io.Writer) error {
+	buf := bufio.NewWriter(w)
+	if _, err := buf.WriteString(xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(buf)
+	encoder.Indent("", "  ")
+
+	collection := xml.StartElement{
+		Name: xml.Name{Local: "collection"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: marcXMLNamespace}},
+	}
+	if err := encoder.EncodeToken(collection); err != nil {
+		return err
+	}
+
+	err := e.eachPage(ctx, func(books []*pb.Book) error {
+		for _, book := range books {
+			if err := encoder.Encode(marcXML(marcBook(book, e.authors[book.AuthorId], e.currency))); err != nil {
+				return err
+			}
+		}
+
+		if err := encoder.Flush(); err != nil {
+			return err
+		}
+		return buf.Flush()
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = encoder.EncodeToken(collection.End()); err != nil {
+		return err
+	}
+	if err = encoder.Flush(); err != nil {
+		return err
+	}
+
+	return buf.Flush()
+}
+
+func parseTimestamp(s string) (time.Time, bool) {
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
@@ -0,0 +1,188 @@
+package exporter
+
+import (
+	"bytes"
+	"encoding/xml"
+	"reflect"
+	"strconv"
+	"testing"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+)
+
+var marcTestBook = &pb.Book{
+	BookId:     "6f1c1c1e-8d4b-4c57-9a54-1b1f0f3f0a01",
+	Name:       "Война и мир",
+	Price:      12.5,
+	Isbn:       "9780306406157",
+	ExternalId: "e1",
+	CreatedAt:  "2021-03-04 05:06:07.123456",
+	UpdatedAt:  "2022-01-02T03:04:05Z",
+	Categories: []*pb.Category{{Name: "Novel"}, {Name: "Classic"}},
+}
+
+// decodeMarc21 reads an ISO 2709 record back into its fields, failing the
+// test on anything the leader or the directory gets wrong
+func decodeMarc21(t *testing.T, record []byte) marcRecord {
+	t.Helper()
+
+	if len(record) < marcLeaderLength {
+		t.Fatalf("record of %d bytes is shorter than the leader", len(record))
+	}
+	leader := string(record[:marcLeaderLength])
+	recordLength, err := strconv.Atoi(leader[0:5])
+	if err != nil || recordLength != len(record) {
+		t.Fatalf("leader %q has record length %s, the record is %d bytes", leader, leader[0:5], len(record))
+	}
+	if leader[5:12] != "nam a22" || leader[17:24] != "   4500" {
+		t.Fatalf("got leader %q, want a language material record with the 4500 entry map", leader)
+	}
+	baseAddress, err := strconv.Atoi(leader[12:17])
+	if err != nil || baseAddress >= len(record) || record[baseAddress-1] != marcFieldTerminator {
+		t.Fatalf("base address %s of leader %q doesn't follow the directory", leader[12:17], leader)
+	}
+	if record[len(record)-1] != marcRecordTerminator {
+		t.Fatal("record doesn't end with the record terminator")
+	}
+
+	directory := record[marcLeaderLength : baseAddress-1]
+	if len(directory)%12 != 0 {
+		t.Fatalf("directory of %d bytes isn't made of 12 byte entries", len(directory))
+	}
+	data := record[baseAddress : len(record)-1]
+
+	var r marcRecord
+	end := 0
+	for i := 0; i < len(directory); i += 12 {
+		entry := string(directory[i : i+12])
+		length, errLength := strconv.Atoi(entry[3:7])
+		start, errStart := strconv.Atoi(entry[7:12])
+		if errLength != nil || errStart != nil || start != end || start+length > len(data) {
+			t.Fatalf("directory entry %q doesn't point at the field following the previous one at %d", entry, end)
+		}
+		end = start + length
+
+		value := data[start:end]
+		if value[len(value)-1] != marcFieldTerminator {
+			t.Fatalf("field %s doesn't end with the field terminator", entry[:3])
+		}
+		value = value[:len(value)-1]
+
+		field := marcField{tag: entry[:3]}
+		if field.control() {
+			field.value = string(value)
+			r.fields = append(r.fields, field)
+			continue
+		}
+
+		field.ind1, field.ind2 = value[0], value[1]
+		for _, subfield := range bytes.Split(value[2:], []byte{marcSubfieldDelimiter})[1:] {
+			field.subfields = append(field.subfields, marcSubfield{subfield[0], string(subfield[1:])})
+		}
+		r.fields = append(r.fields, field)
+	}
+	if end != len(data) {
+		t.Fatalf("directory covers %d of %d bytes of data", end, len(data))
+	}
+
+	return r
+}
+
+func TestEncodeMarc21(t *testing.T) {
+	want := marcBook(marcTestBook, "Толстой, Лев", "USD")
+
+	got := decodeMarc21(t, encodeMarc21(want))
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got fields %+v, want %+v", got.fields, want.fields)
+	}
+}
+
+func TestMarcBook(t *testing.T) {
+	tests := []struct {
+		name   string
+		author string
+		fields map[string]marcField
+	}{
+		{
+			name:   "name in direct order",
+			author: "Leo Tolstoy",
+			fields: map[string]marcField{
+				"100": {tag: "100", ind1: '0', ind2: ' ', subfields: []marcSubfield{{'a', "Leo Tolstoy"}}},
+				"245": {tag: "245", ind1: '1', ind2: '0', subfields: []marcSubfield{{'a', "Война и мир"}}},
+			},
+		},
+		{
+			name:   "inverted name",
+			author: "Tolstoy, Leo",
+			fields: map[string]marcField{
+				"100": {tag: "100", ind1: '1', ind2: ' ', subfields: []marcSubfield{{'a', "Tolstoy, Leo"}}},
+			},
+		},
+		{
+			name: "no author",
+			fields: map[string]marcField{
+				"245": {tag: "245", ind1: '0', ind2: '0', subfields: []marcSubfield{{'a', "Война и мир"}}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := marcBook(marcTestBook, tt.author, "EUR")
+
+			byTag := map[string]marcField{}
+			for _, field := range r.fields {
+				if _, ok := byTag[field.tag]; !ok {
+					byTag[field.tag] = field
+				}
+			}
+
+			price := marcField{tag: "365", ind1: ' ', ind2: ' ', subfields: []marcSubfield{{'b', "12.50"}, {'c', "EUR"}}}
+			if !reflect.DeepEqual(byTag["365"], price) {
+				t.Fatalf("got price field %+v, want %+v", byTag["365"], price)
+			}
+			if got := byTag["008"].value[:6]; got != "210304" {
+				t.Fatalf("got date entered %q, want 210304", got)
+			}
+			if got := byTag["005"].value; got != "20220102030405.0" {
+				t.Fatalf("got latest transaction %q, want 20220102030405.0", got)
+			}
+			if _, ok := byTag["100"]; ok != (tt.author != "") {
+				t.Fatalf("got a 100 field %t, want one only for books with an author", ok)
+			}
+			for tag, want := range tt.fields {
+				if !reflect.DeepEqual(byTag[tag], want) {
+					t.Fatalf("got field %+v, want %+v", byTag[tag], want)
+				}
+			}
+		})
+	}
+}
+
+func TestMarcXML(t *testing.T) {
+	r := marcBook(marcTestBook, "Толстой, Лев", "USD")
+
+	out, err := xml.Marshal(marcXML(r))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var decoded marcXMLRecord
+	if err = xml.Unmarshal(out, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded.ControlFields)+len(decoded.DataFields) != len(r.fields) {
+		t.Fatalf("got %d control and %d data fields, want %d fields",
+			len(decoded.ControlFields), len(decoded.DataFields), len(r.fields))
+	}
+
+	author := marcXMLDataField{
+		Tag:       "100",
+		Ind1:      "1",
+		Ind2:      " ",
+		Subfields: []marcXMLSubfield{{Code: "a", Value: "Толстой, Лев"}},
+	}
+	if !reflect.DeepEqual(decoded.DataFields[2], author) {
+		t.Fatalf("got author field %+v, want %+v", decoded.DataFields[2], author)
+	}
+}
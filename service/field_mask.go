@@ -0,0 +1,75 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	"github.com/abdullohsattorov/catalog-service/storage/repo"
+)
+
+// normalizeMask checks paths of the update mask against the fields allowed
+// to be updated and spells them the way the storage expects, so both
+// "AuthorId" and "author_id" are accepted. An empty mask selects every field.
+func normalizeMask(mask *types.FieldMask, allowed []string) (*types.FieldMask, error) {
+	if len(mask.GetPaths()) == 0 {
+		return &types.FieldMask{Paths: allowed}, nil
+	}
+
+	paths := make([]string, 0, len(mask.Paths))
+	for _, path := range mask.Paths {
+		field, ok := matchField(path, allowed)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "field %q can't be updated, allowed fields: %s",
+				path, strings.Join(allowed, ", "))
+		}
+		paths = append(paths, field)
+	}
+
+	return &types.FieldMask{Paths: paths}, nil
+}
+
+// bookMask normalizes the mask of a book update. An update adding or
+// removing categories with an empty mask changes only the categories, the
+// rest of the book is kept.
+func bookMask(req *pb.UpdateBookReq) (*types.FieldMask, error) {
+	changesCategories := len(req.AddCategoryIds) > 0 || len(req.RemoveCategoryIds) > 0
+	if changesCategories && len(req.UpdateMask.GetPaths()) == 0 {
+		return &types.FieldMask{}, nil
+	}
+
+	mask, err := normalizeMask(req.UpdateMask, repo.BookFields)
+	if err != nil {
+		return nil, err
+	}
+
+	if changesCategories && maskHas(mask, repo.FieldCategoryID) {
+		return nil, status.Error(codes.InvalidArgument, "CategoryId can't be both replaced and changed by add and remove")
+	}
+
+	return mask, nil
+}
+
+func matchField(path string, allowed []string) (string, bool) {
+	path = strings.ReplaceAll(path, "_", "")
+	for _, field := range allowed {
+		if strings.EqualFold(path, field) {
+			return field, true
+		}
+	}
+
+	return "", false
+}
+
+func maskHas(mask *types.FieldMask, field string) bool {
+	for _, path := range mask.GetPaths() {
+		if path == field {
+			return true
+		}
+	}
+
+	return false
+}
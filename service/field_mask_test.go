@@ -0,0 +1,107 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	"github.com/abdullohsattorov/catalog-service/storage/repo"
+)
+
+func TestNormalizeMask(t *testing.T) {
+	tests := []struct {
+		name  string
+		mask  *types.FieldMask
+		paths []string
+		code  codes.Code
+	}{
+		{
+			name:  "no mask selects every field",
+			paths: repo.BookFields,
+		},
+		{
+			name:  "empty mask selects every field",
+			mask:  &types.FieldMask{},
+			paths: repo.BookFields,
+		},
+		{
+			name:  "field names in any case",
+			mask:  &types.FieldMask{Paths: []string{"author_id", "price", "ExternalId"}},
+			paths: []string{repo.FieldAuthorID, repo.FieldPrice, repo.FieldExternalID},
+		},
+		{
+			name: "field which can't be updated",
+			mask: &types.FieldMask{Paths: []string{"Name", "Version"}},
+			code: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mask, err := normalizeMask(tt.mask, repo.BookFields)
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("got code %s (%v), want %s", code, err, tt.code)
+			}
+			if err == nil && !reflect.DeepEqual(mask.Paths, tt.paths) {
+				t.Fatalf("got paths %v, want %v", mask.Paths, tt.paths)
+			}
+		})
+	}
+}
+
+func TestBookMask(t *testing.T) {
+	tests := []struct {
+		name  string
+		req   *pb.UpdateBookReq
+		paths []string
+		code  codes.Code
+	}{
+		{
+			name:  "empty mask replaces the book",
+			req:   &pb.UpdateBookReq{},
+			paths: repo.BookFields,
+		},
+		{
+			name:  "adding categories with an empty mask changes only the categories",
+			req:   &pb.UpdateBookReq{AddCategoryIds: []string{"c1"}},
+			paths: nil,
+		},
+		{
+			name:  "removing categories with an empty mask changes only the categories",
+			req:   &pb.UpdateBookReq{UpdateMask: &types.FieldMask{}, RemoveCategoryIds: []string{"c1"}},
+			paths: nil,
+		},
+		{
+			name: "fields besides added categories",
+			req: &pb.UpdateBookReq{
+				UpdateMask:     &types.FieldMask{Paths: []string{"price"}},
+				AddCategoryIds: []string{"c1"},
+			},
+			paths: []string{repo.FieldPrice},
+		},
+		{
+			name: "categories both replaced and added",
+			req: &pb.UpdateBookReq{
+				UpdateMask:     &types.FieldMask{Paths: []string{"category_id"}},
+				AddCategoryIds: []string{"c1"},
+			},
+			code: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mask, err := bookMask(tt.req)
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("got code %s (%v), want %s", code, err, tt.code)
+			}
+			if err == nil && !reflect.DeepEqual(mask.GetPaths(), tt.paths) {
+				t.Fatalf("got paths %v, want %v", mask.GetPaths(), tt.paths)
+			}
+		})
+	}
+}
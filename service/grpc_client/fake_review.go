@@ -0,0 +1,33 @@
+package grpcClient
+
+import (
+	"context"
+	"hash/fnv"
+
+	"google.golang.org/grpc"
+
+	reviewPb "github.com/abdullohsattorov/catalog-service/genproto/review_service"
+)
+
+// fakeReviewService stands in for review_service during local development.
+// Ratings are derived from the book id, so they are stable between calls.
+type fakeReviewService struct{}
+
+func (fakeReviewService) GetBookRatings(
+	ctx context.Context, in *reviewPb.BookIdsReq, opts ...grpc.CallOption,
+) (*reviewPb.BookRatingsResp, error) {
+	resp := &reviewPb.BookRatingsResp{}
+	for _, id := range in.BookIds {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(id))
+		sum := h.Sum32()
+
+		resp.Ratings = append(resp.Ratings, &reviewPb.BookRating{
+			BookId:        id,
+			AverageRating: 1 + float32(sum%401)/100,
+			ReviewCount:   int64(sum % 500),
+		})
+	}
+
+	return resp, nil
+}
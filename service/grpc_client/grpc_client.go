@@ -1,22 +1,198 @@
 package grpcClient
 
 import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/health" // enables client side health checking
+	"google.golang.org/grpc/keepalive"
+
 	"github.com/abdullohsattorov/catalog-service/config"
+	orderPb "github.com/abdullohsattorov/catalog-service/genproto/order_service"
+	reviewPb "github.com/abdullohsattorov/catalog-service/genproto/review_service"
+	"github.com/abdullohsattorov/catalog-service/pkg/logger"
+	"github.com/abdullohsattorov/catalog-service/pkg/tlsutil"
+)
+
+const (
+	orderService  = "order_service"
+	reviewService = "review_service"
 )
 
+// serviceConfig makes every connection round robin over the resolved
+// addresses, skip the ones failing grpc.health.v1 checks, bound each call
+// with a timeout and retry calls rejected as UNAVAILABLE.
+const serviceConfig = `{
+	"loadBalancingConfig": [{"round_robin": {}}],
+	"healthCheckConfig": {"serviceName": ""},
+	"methodConfig": [{
+		"name": [{}],
+		"timeout": "%gs",
+		"retryPolicy": {
+			"maxAttempts": %d,
+			"initialBackoff": "0.1s",
+			"maxBackoff": "1s",
+			"backoffMultiplier": 2,
+			"retryableStatusCodes": ["UNAVAILABLE"]
+		}
+	}]
+}`
+
 // IGrpcClient ...
-type IGrpcClient interface{}
+type IGrpcClient interface {
+	OrderService() orderPb.OrderServiceClient
+	ReviewService() reviewPb.ReviewServiceClient
+	States() map[string]connectivity.State
+	Close() error
+}
 
 // GrpcClient ...
 type GrpcClient struct {
 	cfg         config.Config
-	connections map[string]interface{}
+	creds       credentials.TransportCredentials
+	mu          sync.Mutex
+	targets     map[string]string
+	connections map[string]*grpc.ClientConn
 }
 
 // New ...
-func New(cfg config.Config) (*GrpcClient, error) {
-	return &GrpcClient{
-		cfg:         cfg,
-		connections: map[string]interface{}{},
-	}, nil
+func New(cfg config.Config, log logger.Logger) (*GrpcClient, error) {
+	creds := insecure.NewCredentials()
+	if cfg.GrpcClientTLS {
+		tlsCfg, err := tlsutil.ClientConfig(tlsutil.ClientOptions{
+			CAFile:     cfg.GrpcClientCAFile,
+			CertFile:   cfg.GrpcClientCertFile,
+			KeyFile:    cfg.GrpcClientKeyFile,
+			ServerName: cfg.GrpcClientServerName,
+		}, log)
+		if err != nil {
+			return nil, fmt.Errorf("grpc client tls: %w", err)
+		}
+		creds = credentials.NewTLS(tlsCfg)
+	}
+
+	g := &GrpcClient{
+		cfg:   cfg,
+		creds: creds,
+		targets: map[string]string{
+			orderService: fmt.Sprintf("dns:///%s:%d", cfg.OrderServiceHost, cfg.OrderServicePort),
+		},
+		connections: map[string]*grpc.ClientConn{},
+	}
+
+	if !cfg.ReviewServiceFake {
+		g.targets[reviewService] = fmt.Sprintf("dns:///%s:%d", cfg.ReviewServiceHost, cfg.ReviewServicePort)
+	}
+
+	for name := range g.targets {
+		if _, err := g.conn(name); err != nil {
+			_ = g.Close()
+			return nil, fmt.Errorf("grpc dial %s: %w", name, err)
+		}
+	}
+
+	return g, nil
+}
+
+// OrderService returns the order_service client. If it can't be dialed the
+// client fails every call with UNAVAILABLE.
+func (g *GrpcClient) OrderService() orderPb.OrderServiceClient {
+	conn, err := g.conn(orderService)
+	if err != nil {
+		return unavailableOrderService{err: err}
+	}
+
+	return orderPb.NewOrderServiceClient(conn)
+}
+
+// ReviewService returns the review_service client, failing like
+// OrderService when it can't be dialed
+func (g *GrpcClient) ReviewService() reviewPb.ReviewServiceClient {
+	if g.cfg.ReviewServiceFake {
+		return fakeReviewService{}
+	}
+
+	conn, err := g.conn(reviewService)
+	if err != nil {
+		return unavailableReviewService{err: err}
+	}
+
+	return reviewPb.NewReviewServiceClient(conn)
+}
+
+// States returns the connectivity state of every downstream connection
+func (g *GrpcClient) States() map[string]connectivity.State {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	states := make(map[string]connectivity.State, len(g.connections))
+	for name, conn := range g.connections {
+		states[name] = conn.GetState()
+	}
+
+	return states
+}
+
+// Close closes every downstream connection
+func (g *GrpcClient) Close() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	var firstErr error
+	for name, conn := range g.connections {
+		if err := conn.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+		delete(g.connections, name)
+	}
+
+	return firstErr
+}
+
+// conn returns the cached connection of the service. A connection stuck in
+// TRANSIENT_FAILURE is told to reconnect right away instead of waiting for its
+// backoff, and a connection that has been shut down is dialed again.
+func (g *GrpcClient) conn(name string) (*grpc.ClientConn, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	conn, ok := g.connections[name]
+	if ok {
+		switch conn.GetState() {
+		case connectivity.TransientFailure:
+			conn.ResetConnectBackoff()
+			return conn, nil
+		case connectivity.Shutdown:
+		default:
+			return conn, nil
+		}
+	}
+
+	newConn, err := g.dial(g.targets[name])
+	if err != nil {
+		return nil, err
+	}
+	g.connections[name] = newConn
+
+	return newConn, nil
+}
+
+func (g *GrpcClient) dial(target string) (*grpc.ClientConn, error) {
+	return grpc.Dial(target,
+		grpc.WithTransportCredentials(g.creds),
+		grpc.WithChainUnaryInterceptor(otelgrpc.UnaryClientInterceptor(), logger.UnaryClientInterceptor()),
+		grpc.WithStreamInterceptor(otelgrpc.StreamClientInterceptor()),
+		grpc.WithDefaultServiceConfig(fmt.Sprintf(serviceConfig, g.cfg.GrpcClientTimeout.Seconds(), g.cfg.GrpcClientMaxAttempts)),
+		grpc.WithKeepaliveParams(keepalive.ClientParameters{
+			Time:                30 * time.Second,
+			Timeout:             10 * time.Second,
+			PermitWithoutStream: true,
+		}),
+	)
 }
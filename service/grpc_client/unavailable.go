@@ -0,0 +1,51 @@
+package grpcClient
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	orderPb "github.com/abdullohsattorov/catalog-service/genproto/order_service"
+	reviewPb "github.com/abdullohsattorov/catalog-service/genproto/review_service"
+)
+
+// unavailableOrderService is handed out when order_service can't be dialed,
+// every call fails with UNAVAILABLE carrying the dial error
+type unavailableOrderService struct {
+	err error
+}
+
+func (s unavailableOrderService) Create(context.Context, *orderPb.OrderReq, ...grpc.CallOption) (*orderPb.OrderResp, error) {
+	return nil, unavailable(orderService, s.err)
+}
+
+func (s unavailableOrderService) Get(context.Context, *orderPb.ByIdReq, ...grpc.CallOption) (*orderPb.OrderResp, error) {
+	return nil, unavailable(orderService, s.err)
+}
+
+func (s unavailableOrderService) List(context.Context, *orderPb.ListReq, ...grpc.CallOption) (*orderPb.ListResp, error) {
+	return nil, unavailable(orderService, s.err)
+}
+
+func (s unavailableOrderService) Update(context.Context, *orderPb.OrderReq, ...grpc.CallOption) (*orderPb.OrderResp, error) {
+	return nil, unavailable(orderService, s.err)
+}
+
+func (s unavailableOrderService) Delete(context.Context, *orderPb.ByIdReq, ...grpc.CallOption) (*orderPb.EmptyResp, error) {
+	return nil, unavailable(orderService, s.err)
+}
+
+// unavailableReviewService is unavailableOrderService for review_service
+type unavailableReviewService struct {
+	err error
+}
+
+func (s unavailableReviewService) GetBookRatings(context.Context, *reviewPb.BookIdsReq, ...grpc.CallOption) (*reviewPb.BookRatingsResp, error) {
+	return nil, unavailable(reviewService, s.err)
+}
+
+func unavailable(service string, err error) error {
+	return status.Errorf(codes.Unavailable, "%s can't be dialed: %v", service, err)
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	"github.com/abdullohsattorov/catalog-service/pkg/auth"
+	l "github.com/abdullohsattorov/catalog-service/pkg/logger"
+	"github.com/abdullohsattorov/catalog-service/storage/repo"
+)
+
+// Idempotency metadata. Retries of a request sent with the same key get the
+// response of the first one, which is marked with the replayed header.
+const (
+	idempotencyKeyHeader      = "idempotency-key"
+	idempotencyReplayedHeader = "idempotency-replayed"
+
+	maxIdempotencyKeyLength = 128
+
+	// idempotencyPurgeInterval is how often expired idempotency keys are
+	// deleted
+	idempotencyPurgeInterval = time.Hour
+	// idempotencyStoreTimeout bounds storing the response of a request or
+	// releasing its key, which happen after the request may have been
+	// cancelled
+	idempotencyStoreTimeout = 5 * time.Second
+)
+
+type message interface {
+	Marshal() ([]byte, error)
+	Unmarshal(data []byte) error
+}
+
+// idempotentMethods are the methods honouring idempotency keys, with the
+// constructors of their responses
+var idempotentMethods = map[string]func() message{
+	"/catalog.CatalogService/CreateBook":       func() message { return &pb.Book{} },
+	"/catalog.CatalogService/CreateAuthor":     func() message { return &pb.Author{} },
+	"/catalog.CatalogService/CreateCategory":   func() message { return &pb.Category{} },
+	"/catalog.CatalogService/BatchCreateBooks": func() message { return &pb.BatchBooksResp{} },
+}
+
+// IdempotencyInterceptor makes create methods safe to retry. The first
+// request with a key is handled and its response stored with the hash of
+// the request, retries get the stored response and reusing the key for a
+// different request is rejected. Failed requests aren't stored. A request
+// holds its key for idempotencyLease, so a retry of a request which never
+// completed, e.g. since the server crashed, is handled once the lease ends.
+// Keys are scoped to the subject of the caller's token, so callers never get
+// each other's responses.
+func (s *CatalogService) IdempotencyInterceptor(
+	ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (interface{}, error) {
+	newResponse, ok := idempotentMethods[info.FullMethod]
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	keys := md.Get(idempotencyKeyHeader)
+	if len(keys) == 0 || keys[0] == "" {
+		return handler(ctx, req)
+	}
+	key := keys[0]
+	if len(key) > maxIdempotencyKeyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "idempotency key is longer than %d characters", maxIdempotencyKeyLength)
+	}
+
+	hash, err := requestHash(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "failed to hash request")
+	}
+
+	reservation := repo.IdempotencyKey{
+		Key:         key,
+		Method:      info.FullMethod,
+		Caller:      idempotencyCaller(ctx),
+		RequestHash: hash,
+	}
+	stored, reserved, err := s.storage.Idempotency().ReserveIdempotencyKey(
+		ctx, reservation, time.Now().Add(-s.idempotencyTTL), time.Now().Add(s.idempotencyLease))
+	if err != nil {
+		s.log(ctx).Error("failed to reserve idempotency key", l.Error(err))
+		return nil, status.Error(codes.Internal, "failed to check idempotency key")
+	}
+
+	if !reserved {
+		return s.replay(ctx, stored, hash, newResponse())
+	}
+
+	resp, err := handler(ctx, req)
+
+	// the caller may have given up on the request by now, which is when it
+	// retries, so the outcome is recorded even though ctx is cancelled
+	storeCtx, cancel := context.WithTimeout(detachedContext{ctx}, idempotencyStoreTimeout)
+	defer cancel()
+
+	if err != nil {
+		if releaseErr := s.storage.Idempotency().ReleaseIdempotencyKey(storeCtx, reservation); releaseErr != nil {
+			s.log(ctx).Error("failed to release idempotency key", l.Error(releaseErr))
+		}
+		return nil, err
+	}
+
+	if err = s.storeResponse(storeCtx, reservation, resp); err != nil {
+		s.log(ctx).Error("failed to store response of idempotency key", l.Error(err), l.String("key", key))
+	}
+
+	return resp, nil
+}
+
+// idempotencyCaller is the subject of the caller's token, callers of a
+// service without authentication can't be told apart and share their keys
+func idempotencyCaller(ctx context.Context) string {
+	if principal, ok := auth.FromContext(ctx); ok {
+		return principal.Subject
+	}
+
+	return ""
+}
+
+// detachedContext keeps the values of a request's context, such as its
+// logger and span, without being cancelled along with it
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (detachedContext) Err() error {
+	return nil
+}
+
+func (s *CatalogService) replay(ctx context.Context, stored repo.IdempotencyKey, hash string, resp message) (interface{}, error) {
+	if stored.RequestHash != hash {
+		return nil, status.Error(codes.InvalidArgument, "idempotency key was already used for a different request")
+	}
+	if stored.Response == nil {
+		return nil, status.Error(codes.Aborted, "request with this idempotency key is still in progress, retry later")
+	}
+
+	if err := resp.Unmarshal(stored.Response); err != nil {
+		s.log(ctx).Error("failed to unmarshal stored response", l.Error(err), l.String("key", stored.Key))
+		return nil, status.Error(codes.Internal, "failed to replay response")
+	}
+	_ = grpc.SetHeader(ctx, metadata.Pairs(idempotencyReplayedHeader, "true"))
+
+	return resp, nil
+}
+
+func (s *CatalogService) storeResponse(ctx context.Context, key repo.IdempotencyKey, resp interface{}) error {
+	data, err := resp.(message).Marshal()
+	if err != nil {
+		return err
+	}
+
+	return s.storage.Idempotency().CompleteIdempotencyKey(ctx, key, data)
+}
+
+func requestHash(req interface{}) (string, error) {
+	data, err := req.(message).Marshal()
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// PurgeIdempotencyKeys deletes keys older than their TTL every
+// idempotencyPurgeInterval until ctx is done
+func (s *CatalogService) PurgeIdempotencyKeys(ctx context.Context) {
+	ticker := time.NewTicker(idempotencyPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		n, err := s.storage.Idempotency().PurgeIdempotencyKeys(ctx, time.Now().Add(-s.idempotencyTTL))
+		if err != nil {
+			if ctx.Err() == nil {
+				s.logger.Error("failed to purge idempotency keys", l.Error(err))
+			}
+			continue
+		}
+		if n > 0 {
+			s.logger.Info("purged idempotency keys", l.Int("count", int(n)))
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	"github.com/abdullohsattorov/catalog-service/pkg/auth"
+	l "github.com/abdullohsattorov/catalog-service/pkg/logger"
+	"github.com/abdullohsattorov/catalog-service/storage/repo"
+)
+
+// fakeIdempotencyStorage keeps keys in memory. Like the database, it fails
+// calls whose context is done.
+type fakeIdempotencyStorage struct {
+	mu   sync.Mutex
+	keys map[[3]string]repo.IdempotencyKey
+}
+
+func newFakeIdempotencyStorage() *fakeIdempotencyStorage {
+	return &fakeIdempotencyStorage{keys: map[[3]string]repo.IdempotencyKey{}}
+}
+
+func idOf(key repo.IdempotencyKey) [3]string {
+	return [3]string{key.Key, key.Method, key.Caller}
+}
+
+func (f *fakeIdempotencyStorage) ReserveIdempotencyKey(
+	ctx context.Context, key repo.IdempotencyKey, expiresBefore, lockedUntil time.Time,
+) (repo.IdempotencyKey, bool, error) {
+	if err := ctx.Err(); err != nil {
+		return repo.IdempotencyKey{}, false, err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if stored, ok := f.keys[idOf(key)]; ok {
+		return stored, false, nil
+	}
+	f.keys[idOf(key)] = key
+
+	return key, true, nil
+}
+
+func (f *fakeIdempotencyStorage) CompleteIdempotencyKey(ctx context.Context, key repo.IdempotencyKey, response []byte) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	stored := f.keys[idOf(key)]
+	stored.Response = response
+	f.keys[idOf(key)] = stored
+
+	return nil
+}
+
+func (f *fakeIdempotencyStorage) ReleaseIdempotencyKey(ctx context.Context, key repo.IdempotencyKey) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.keys[idOf(key)].Response == nil {
+		delete(f.keys, idOf(key))
+	}
+
+	return nil
+}
+
+func (f *fakeIdempotencyStorage) PurgeIdempotencyKeys(ctx context.Context, before time.Time) (int64, error) {
+	return 0, nil
+}
+
+func newIdempotencyTestService(keys *fakeIdempotencyStorage) *CatalogService {
+	return &CatalogService{
+		storage:          fakeStorage{idempotency: keys},
+		logger:           l.New(l.LevelError, "test"),
+		idempotencyTTL:   time.Hour,
+		idempotencyLease: time.Minute,
+	}
+}
+
+func withIdempotencyKey(ctx context.Context, key, subject string) context.Context {
+	if subject != "" {
+		ctx = auth.NewContext(ctx, auth.Principal{Subject: subject})
+	}
+
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(idempotencyKeyHeader, key))
+}
+
+var createAuthorInfo = &grpc.UnaryServerInfo{FullMethod: "/catalog.CatalogService/CreateAuthor"}
+
+func TestIdempotencyStoresResponseOfCancelledRequest(t *testing.T) {
+	keys := newFakeIdempotencyStorage()
+	s := newIdempotencyTestService(keys)
+	req := &pb.Author{Name: "Tolstoy"}
+
+	// the caller times out while the author is created, the create commits
+	// anyway
+	ctx, cancel := context.WithCancel(withIdempotencyKey(context.Background(), "k1", "user-1"))
+	calls := 0
+	_, err := s.IdempotencyInterceptor(ctx, req, createAuthorInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		cancel()
+		return &pb.Author{AuthorId: "a1", Name: "Tolstoy"}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the retry gets the response of the first request instead of creating
+	// the author again
+	resp, err := s.IdempotencyInterceptor(withIdempotencyKey(context.Background(), "k1", "user-1"), req, createAuthorInfo,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			calls++
+			return &pb.Author{AuthorId: "a2", Name: "Tolstoy"}, nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 {
+		t.Fatalf("handler was called %d times, want 1", calls)
+	}
+	if id := resp.(*pb.Author).AuthorId; id != "a1" {
+		t.Fatalf("got author %s, want the replayed a1", id)
+	}
+}
+
+func TestIdempotencyReleasesKeyOfCancelledRequest(t *testing.T) {
+	keys := newFakeIdempotencyStorage()
+	s := newIdempotencyTestService(keys)
+	req := &pb.Author{Name: "Tolstoy"}
+
+	ctx, cancel := context.WithCancel(withIdempotencyKey(context.Background(), "k1", "user-1"))
+	_, err := s.IdempotencyInterceptor(ctx, req, createAuthorInfo, func(ctx context.Context, req interface{}) (interface{}, error) {
+		cancel()
+		return nil, status.Error(codes.Canceled, "context canceled")
+	})
+	if status.Code(err) != codes.Canceled {
+		t.Fatalf("got %v, want Canceled", err)
+	}
+
+	if len(keys.keys) != 0 {
+		t.Fatalf("got keys %v, want the key of the failed request released", keys.keys)
+	}
+}
+
+func TestIdempotencyKeysAreScopedToCaller(t *testing.T) {
+	tests := []struct {
+		name     string
+		subject  string
+		req      *pb.Author
+		code     codes.Code
+		replayed bool
+	}{
+		{
+			name:     "same caller replays",
+			subject:  "user-1",
+			req:      &pb.Author{Name: "Tolstoy"},
+			replayed: true,
+		},
+		{
+			name:    "same caller with a different request",
+			subject: "user-1",
+			req:     &pb.Author{Name: "Chekhov"},
+			code:    codes.InvalidArgument,
+		},
+		{
+			name:    "another caller with the same key",
+			subject: "user-2",
+			req:     &pb.Author{Name: "Tolstoy"},
+		},
+		{
+			name: "anonymous caller with the same key",
+			req:  &pb.Author{Name: "Tolstoy"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keys := newFakeIdempotencyStorage()
+			s := newIdempotencyTestService(keys)
+
+			_, err := s.IdempotencyInterceptor(withIdempotencyKey(context.Background(), "k1", "user-1"), &pb.Author{Name: "Tolstoy"}, createAuthorInfo,
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					return &pb.Author{AuthorId: "a1"}, nil
+				})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := s.IdempotencyInterceptor(withIdempotencyKey(context.Background(), "k1", tt.subject), tt.req, createAuthorInfo,
+				func(ctx context.Context, req interface{}) (interface{}, error) {
+					return &pb.Author{AuthorId: "a2"}, nil
+				})
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("got code %s (%v), want %s", code, err, tt.code)
+			}
+			if err != nil {
+				return
+			}
+
+			want := "a2"
+			if tt.replayed {
+				want = "a1"
+			}
+			if id := resp.(*pb.Author).AuthorId; id != want {
+				t.Fatalf("got author %s, want %s", id, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"errors"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	l "github.com/abdullohsattorov/catalog-service/pkg/logger"
+	"github.com/abdullohsattorov/catalog-service/service/exporter"
+	"github.com/abdullohsattorov/catalog-service/service/importer"
+)
+
+// NewImporter returns an importer, its changes are audited as made by the
+// actor of the context passed to Import
+func (s *CatalogService) NewImporter(opts importer.Options) *importer.Importer {
+	return importer.New(s.storage, opts)
+}
+
+// NewExporter ...
+func (s *CatalogService) NewExporter() *exporter.Exporter {
+	return exporter.New(s.storage, s.priceCurrency)
+}
+
+func (s *CatalogService) ImportCatalog(stream pb.CatalogService_ImportCatalogServer) error {
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Error(codes.InvalidArgument, "file is empty")
+	}
+	if err != nil {
+		return err
+	}
+
+	records, rowErrors, err := importer.ReadCSV(&chunkReader{stream: stream, buf: first.Chunk})
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "failed to read csv: %v", err)
+	}
+
+	im := s.NewImporter(importer.Options{
+		DryRun:        first.DryRun,
+		CreateMissing: first.CreateMissing,
+	})
+
+	report, err := im.Import(stream.Context(), records, rowErrors)
+	if err != nil {
+		s.log(stream.Context()).Error("failed to import catalog", l.Error(err))
+		return status.Error(codes.Internal, "failed to import catalog")
+	}
+
+	return stream.SendAndClose(importReport(report))
+}
+
+func importReport(report importer.Report) *pb.ImportCatalogResp {
+	resp := &pb.ImportCatalogResp{
+		Created:           int64(report.Created),
+		Updated:           int64(report.Updated),
+		Unchanged:         int64(report.Unchanged),
+		CreatedAuthors:    int64(report.CreatedAuthors),
+		CreatedCategories: int64(report.CreatedCategories),
+		DryRun:            report.DryRun,
+	}
+	for _, row := range report.Applied {
+		resp.AppliedRows = append(resp.AppliedRows, int64(row))
+	}
+	for _, rowErr := range report.Errors {
+		resp.Errors = append(resp.Errors, &pb.ImportRowError{
+			Row:     int64(rowErr.Row),
+			Message: rowErr.Message,
+		})
+	}
+
+	return resp
+}
+
+func (s *CatalogService) ExportCatalog(req *pb.ExportCatalogReq, stream pb.CatalogService_ExportCatalogServer) error {
+	err := s.NewExporter().WriteCSV(stream.Context(), chunkWriter(func(data []byte) error {
+		return stream.Send(&pb.CsvChunk{Data: data})
+	}))
+	if err != nil {
+		s.log(stream.Context()).Error("failed to export catalog", l.Error(err))
+		return status.Error(codes.Internal, "failed to export catalog")
+	}
+
+	return nil
+}
+
+func (s *CatalogService) ExportMarc(req *pb.ExportMarcReq, stream pb.CatalogService_ExportMarcServer) error {
+	format := req.Format
+	if format == "" {
+		format = exporter.FormatMarc21
+	}
+	if format != exporter.FormatMarc21 && format != exporter.FormatMarcXML {
+		return status.Errorf(codes.InvalidArgument, "format must be either %s or %s", exporter.FormatMarc21, exporter.FormatMarcXML)
+	}
+
+	err := s.NewExporter().WriteMarc(stream.Context(), chunkWriter(func(data []byte) error {
+		return stream.Send(&pb.MarcChunk{Data: data})
+	}), format)
+	if err != nil {
+		s.log(stream.Context()).Error("failed to export marc records", l.Error(err))
+		return status.Error(codes.Internal, "failed to export marc records")
+	}
+
+	return nil
+}
+
+// chunkReader reads a file sent in chunks by a client stream
+type chunkReader struct {
+	stream pb.CatalogService_ImportCatalogServer
+	buf    []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = req.Chunk
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+
+	return n, nil
+}
+
+// chunkWriter sends every write as a chunk of a server stream
+type chunkWriter func(data []byte) error
+
+func (w chunkWriter) Write(p []byte) (int, error) {
+	if err := w(append([]byte(nil), p...)); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
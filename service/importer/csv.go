@@ -0,0 +1,116 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cast"
+)
+
+// Columns of catalog CSV files, the header row names them in any order
+const (
+	ColumnIsbn       = "isbn"
+	ColumnExternalID = "external_id"
+	ColumnName       = "name"
+	ColumnAuthor     = "author"
+	ColumnPrice      = "price"
+	ColumnCategories = "categories"
+)
+
+// Header is the header row of exported files
+var Header = []string{ColumnIsbn, ColumnExternalID, ColumnName, ColumnAuthor, ColumnPrice, ColumnCategories}
+
+// CategorySeparator separates category names in the categories column
+const CategorySeparator = ";"
+
+var requiredColumns = []string{ColumnName, ColumnAuthor}
+
+// ReadCSV reads records of a catalog CSV file. Rows which can't be read are
+// returned as errors, the error is only set when the file itself is broken.
+func ReadCSV(r io.Reader) ([]Record, []RowError, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil, errors.New("file is empty")
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range requiredColumns {
+		if _, ok := columns[name]; !ok {
+			return nil, nil, fmt.Errorf("column %s is missing", name)
+		}
+	}
+	if _, ok := columns[ColumnIsbn]; !ok {
+		if _, ok = columns[ColumnExternalID]; !ok {
+			return nil, nil, fmt.Errorf("either %s or %s column is required", ColumnIsbn, ColumnExternalID)
+		}
+	}
+
+	var (
+		records []Record
+		errs    []RowError
+		rowNum  = 1
+	)
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		rowNum++
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			errs = append(errs, RowError{Row: rowNum, Message: parseErr.Err.Error()})
+			continue
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		get := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+
+		rec := Record{
+			Row:        rowNum,
+			Isbn:       get(ColumnIsbn),
+			ExternalID: get(ColumnExternalID),
+			Name:       get(ColumnName),
+			Author:     get(ColumnAuthor),
+		}
+
+		// an empty price cell leaves the price of an existing book as it is
+		if price := get(ColumnPrice); price != "" {
+			if rec.Price, err = cast.ToFloat32E(price); err != nil {
+				errs = append(errs, RowError{Row: rowNum, Message: fmt.Sprintf("invalid price %q", price)})
+				continue
+			}
+			rec.HasPrice = true
+		}
+
+		for _, category := range strings.Split(get(ColumnCategories), CategorySeparator) {
+			if category = strings.TrimSpace(category); category != "" {
+				rec.Categories = append(rec.Categories, category)
+			}
+		}
+
+		records = append(records, rec)
+	}
+
+	return records, errs, nil
+}
@@ -0,0 +1,95 @@
+package importer
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		records []Record
+		errs    []RowError
+		failed  bool
+	}{
+		{
+			name: "columns in any order and case",
+			file: "Price,Name,ISBN,Author,Categories\n" +
+				"12.5, War and Peace ,0-306-40615-2,Tolstoy,Novel; Classic ;\n",
+			records: []Record{{
+				Row:        2,
+				Isbn:       "0-306-40615-2",
+				Name:       "War and Peace",
+				Author:     "Tolstoy",
+				Price:      12.5,
+				HasPrice:   true,
+				Categories: []string{"Novel", "Classic"},
+			}},
+		},
+		{
+			name: "empty price cell",
+			file: "external_id,name,author,price\n" +
+				"e1,Anna Karenina,Tolstoy,\n",
+			records: []Record{{Row: 2, ExternalID: "e1", Name: "Anna Karenina", Author: "Tolstoy"}},
+		},
+		{
+			name: "no price column",
+			file: "isbn,name,author\n" +
+				"9780306406157,Resurrection,Tolstoy\n",
+			records: []Record{{Row: 2, Isbn: "9780306406157", Name: "Resurrection", Author: "Tolstoy"}},
+		},
+		{
+			name: "invalid price",
+			file: "isbn,name,author,price\n" +
+				"9780306406157,Resurrection,Tolstoy,free\n" +
+				"9780306406164,Childhood,Tolstoy,3\n",
+			records: []Record{{Row: 3, Isbn: "9780306406164", Name: "Childhood", Author: "Tolstoy", Price: 3, HasPrice: true}},
+			errs:    []RowError{{Row: 2, Message: `invalid price "free"`}},
+		},
+		{
+			name: "row which can't be parsed",
+			file: "isbn,name,author\n" +
+				"9780306406157,\"Resurrection,Tolstoy\n",
+			errs: []RowError{{Row: 2, Message: "extraneous or missing \" in quoted-field"}},
+		},
+		{
+			name:   "empty file",
+			file:   "",
+			failed: true,
+		},
+		{
+			name:   "required column missing",
+			file:   "isbn,name\n9780306406157,Resurrection\n",
+			failed: true,
+		},
+		{
+			name:   "neither isbn nor external_id column",
+			file:   "name,author\nResurrection,Tolstoy\n",
+			failed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records, errs, err := ReadCSV(strings.NewReader(tt.file))
+			if tt.failed {
+				if err == nil {
+					t.Fatalf("got records %+v, want an error", records)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(records, tt.records) {
+				t.Fatalf("got records %+v, want %+v", records, tt.records)
+			}
+			if !reflect.DeepEqual(errs, tt.errs) {
+				t.Fatalf("got errors %+v, want %+v", errs, tt.errs)
+			}
+		})
+	}
+}
@@ -0,0 +1,485 @@
+package importer
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/gogo/protobuf/types"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	"github.com/abdullohsattorov/catalog-service/pkg/utils"
+	"github.com/abdullohsattorov/catalog-service/storage"
+	"github.com/abdullohsattorov/catalog-service/storage/repo"
+)
+
+// maxNameLength is the length of name columns of books, authors and categories
+const maxNameLength = 64
+
+// Options ...
+type Options struct {
+	// DryRun only validates the records
+	DryRun bool
+	// CreateMissing creates authors and categories which aren't found by name
+	CreateMissing bool
+}
+
+// Record is one book of an imported file. Books are matched to the catalog by
+// Isbn or ExternalId, authors and categories by name.
+type Record struct {
+	// Row is the position of the record in the file, for error reports. The
+	// header of a CSV file is row 1.
+	Row        int
+	Isbn       string
+	ExternalID string
+	Name       string
+	Author     string
+	Price      float32
+	// HasPrice tells the record sets Price. Books updated by records without
+	// a price keep theirs.
+	HasPrice   bool
+	Categories []string
+}
+
+// RowError ...
+type RowError struct {
+	Row     int
+	Message string
+}
+
+// Report sums up an import. Counts of a dry run are what the import would do.
+type Report struct {
+	Created           int
+	Updated           int
+	Unchanged         int
+	CreatedAuthors    int
+	CreatedCategories int
+	Errors            []RowError
+	DryRun            bool
+	// Applied are the rows which were imported, written or already matching
+	// the catalog. Rows are written one by one, so when some of them fail to
+	// be written the rows applied before and after them stay written.
+	Applied []int
+}
+
+func (r *Report) addError(row int, format string, args ...interface{}) {
+	r.Errors = append(r.Errors, RowError{Row: row, Message: fmt.Sprintf(format, args...)})
+}
+
+// Importer upserts books into the catalog. Nothing is written unless every
+// record passes validation, then every record is written in a transaction
+// of its own.
+type Importer struct {
+	storage storage.IStorage
+	opts    Options
+
+	authors    map[string][]string
+	categories map[string][]string
+}
+
+// New ...
+func New(storage storage.IStorage, opts Options) *Importer {
+	return &Importer{
+		storage: storage,
+		opts:    opts,
+	}
+}
+
+// plan is what the import does with a valid record
+type plan struct {
+	Record
+	existing *pb.Book
+}
+
+// Import validates the records and, unless it is a dry run or some of them
+// are invalid, writes them. parseErrors are the records which couldn't be
+// read from the file, they fail the import the same way invalid ones do.
+func (im *Importer) Import(ctx context.Context, records []Record, parseErrors []RowError) (Report, error) {
+	report := Report{DryRun: im.opts.DryRun, Errors: parseErrors}
+
+	if err := im.loadNames(ctx); err != nil {
+		return Report{}, err
+	}
+
+	plans, err := im.validate(ctx, records, &report)
+	if err != nil {
+		return Report{}, err
+	}
+
+	if im.opts.DryRun || len(report.Errors) > 0 {
+		im.count(plans, &report)
+		return report, nil
+	}
+
+	for _, p := range plans {
+		if err = im.apply(ctx, p, &report); err != nil {
+			report.addError(p.Row, "%v", err)
+			continue
+		}
+		report.Applied = append(report.Applied, p.Row)
+	}
+
+	return report, nil
+}
+
+func (im *Importer) validate(ctx context.Context, records []Record, report *Report) ([]plan, error) {
+	var (
+		plans     []plan
+		seenIsbn  = map[string]int{}
+		seenExtID = map[string]int{}
+	)
+
+	for _, rec := range records {
+		rec.Name = strings.TrimSpace(rec.Name)
+		rec.Author = strings.TrimSpace(rec.Author)
+		rec.ExternalID = strings.TrimSpace(rec.ExternalID)
+		rec.Categories = uniqueNames(rec.Categories)
+
+		isbn, err := utils.NormalizeISBN(rec.Isbn)
+		if err != nil {
+			report.addError(rec.Row, "invalid isbn %q", rec.Isbn)
+			continue
+		}
+		rec.Isbn = isbn
+
+		if rec.Isbn == "" && rec.ExternalID == "" {
+			report.addError(rec.Row, "isbn or external_id is required")
+			continue
+		}
+		if rec.Name == "" {
+			report.addError(rec.Row, "name is required")
+			continue
+		}
+		if rec.Author == "" {
+			report.addError(rec.Row, "author is required")
+			continue
+		}
+		if msg := checkLength(rec); msg != "" {
+			report.addError(rec.Row, msg)
+			continue
+		}
+		if rec.Price < 0 {
+			report.addError(rec.Row, "price can't be negative")
+			continue
+		}
+
+		if row, ok := seenIsbn[rec.Isbn]; ok && rec.Isbn != "" {
+			report.addError(rec.Row, "isbn %s is already used on row %d", rec.Isbn, row)
+			continue
+		}
+		if row, ok := seenExtID[rec.ExternalID]; ok && rec.ExternalID != "" {
+			report.addError(rec.Row, "external_id %s is already used on row %d", rec.ExternalID, row)
+			continue
+		}
+		seenIsbn[rec.Isbn] = rec.Row
+		seenExtID[rec.ExternalID] = rec.Row
+
+		if msg := im.checkName(im.authors, "author", rec.Author); msg != "" {
+			report.addError(rec.Row, msg)
+			continue
+		}
+
+		var msgs []string
+		for _, category := range rec.Categories {
+			if msg := im.checkName(im.categories, "category", category); msg != "" {
+				msgs = append(msgs, msg)
+			}
+		}
+		if len(msgs) > 0 {
+			report.addError(rec.Row, strings.Join(msgs, "; "))
+			continue
+		}
+
+		found, err := im.storage.Book().FindBooksByIdentifiers(ctx, rec.Isbn, rec.ExternalID)
+		if err != nil {
+			return nil, err
+		}
+		if len(found) > 1 {
+			report.addError(rec.Row, "isbn and external_id belong to different books")
+			continue
+		}
+
+		p := plan{Record: rec}
+		if len(found) == 1 {
+			p.existing = found[0]
+		}
+		plans = append(plans, p)
+	}
+
+	return plans, nil
+}
+
+// checkLength reports names which don't fit into the catalog
+func checkLength(rec Record) string {
+	names := map[string]string{"name": rec.Name, "author": rec.Author}
+	for _, category := range rec.Categories {
+		names["category "+category] = category
+	}
+
+	for field, name := range names {
+		if len([]rune(name)) > maxNameLength {
+			return fmt.Sprintf("%s is longer than %d characters", field, maxNameLength)
+		}
+	}
+
+	return ""
+}
+
+// checkName reports a name which doesn't resolve to exactly one entity
+func (im *Importer) checkName(names map[string][]string, entity, name string) string {
+	switch len(names[nameKey(name)]) {
+	case 0:
+		if !im.opts.CreateMissing {
+			return fmt.Sprintf("%s %q not found", entity, name)
+		}
+	case 1:
+	default:
+		return fmt.Sprintf("%s name %q is ambiguous", entity, name)
+	}
+
+	return ""
+}
+
+// count fills the report of an import which doesn't write anything
+func (im *Importer) count(plans []plan, report *Report) {
+	var (
+		authors    = map[string]bool{}
+		categories = map[string]bool{}
+	)
+
+	for _, p := range plans {
+		switch {
+		case p.existing == nil:
+			report.Created++
+		case im.unchanged(p):
+			report.Unchanged++
+		default:
+			report.Updated++
+		}
+
+		if len(im.authors[nameKey(p.Author)]) == 0 {
+			authors[nameKey(p.Author)] = true
+		}
+		for _, category := range p.Categories {
+			if len(im.categories[nameKey(category)]) == 0 {
+				categories[nameKey(category)] = true
+			}
+		}
+	}
+
+	report.CreatedAuthors = len(authors)
+	report.CreatedCategories = len(categories)
+}
+
+func (im *Importer) apply(ctx context.Context, p plan, report *Report) error {
+	authorID, err := im.authorID(ctx, p.Author, report)
+	if err != nil {
+		return err
+	}
+
+	categoryIDs := make([]string, 0, len(p.Categories))
+	for _, name := range p.Categories {
+		id, err := im.categoryID(ctx, name, report)
+		if err != nil {
+			return err
+		}
+		categoryIDs = append(categoryIDs, id)
+	}
+
+	book := pb.Book{
+		Name:       p.Name,
+		AuthorId:   authorID,
+		Price:      p.Price,
+		CategoryId: categoryIDs,
+		Isbn:       p.Isbn,
+		ExternalId: p.ExternalID,
+	}
+
+	if p.existing == nil {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return err
+		}
+		book.BookId = id.String()
+
+		if _, err = im.storage.Book().CreateBook(ctx, book); err != nil {
+			return fmt.Errorf("failed to create book: %w", err)
+		}
+		report.Created++
+
+		return nil
+	}
+
+	if im.unchanged(p) {
+		report.Unchanged++
+		return nil
+	}
+
+	book.BookId = p.existing.BookId
+	book.Version = p.existing.Version
+	if book.Isbn == "" {
+		book.Isbn = p.existing.Isbn
+	}
+	if book.ExternalId == "" {
+		book.ExternalId = p.existing.ExternalId
+	}
+
+	_, err = im.storage.Book().UpdateBook(ctx, pb.UpdateBookReq{
+		Book:       &book,
+		UpdateMask: &types.FieldMask{Paths: p.fields()},
+	})
+	if errors.Is(err, repo.ErrStaleVersion) || errors.Is(err, sql.ErrNoRows) {
+		return errors.New("book was changed while importing, import the file again")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update book: %w", err)
+	}
+	report.Updated++
+
+	return nil
+}
+
+// fields are the fields of the book the record sets
+func (p plan) fields() []string {
+	if p.HasPrice {
+		return repo.BookFields
+	}
+
+	fields := make([]string, 0, len(repo.BookFields))
+	for _, field := range repo.BookFields {
+		if field != repo.FieldPrice {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
+// unchanged tells if the record matches its existing book. Names of authors
+// and categories missing from the catalog always differ.
+func (im *Importer) unchanged(p plan) bool {
+	b := p.existing
+	if b.Name != p.Name || (p.HasPrice && b.Price != p.Price) ||
+		(p.Isbn != "" && b.Isbn != p.Isbn) || (p.ExternalID != "" && b.ExternalId != p.ExternalID) {
+		return false
+	}
+
+	if ids := im.authors[nameKey(p.Author)]; len(ids) != 1 || ids[0] != b.AuthorId {
+		return false
+	}
+
+	current := map[string]bool{}
+	for _, id := range b.CategoryId {
+		current[id] = true
+	}
+
+	wanted := map[string]bool{}
+	for _, name := range p.Categories {
+		ids := im.categories[nameKey(name)]
+		if len(ids) != 1 || !current[ids[0]] {
+			return false
+		}
+		wanted[ids[0]] = true
+	}
+
+	return len(wanted) == len(current)
+}
+
+func (im *Importer) authorID(ctx context.Context, name string, report *Report) (string, error) {
+	if ids := im.authors[nameKey(name)]; len(ids) > 0 {
+		return ids[0], nil
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return "", err
+	}
+
+	author, err := im.storage.Author().CreateAuthor(ctx, pb.Author{AuthorId: id.String(), Name: name})
+	if err != nil {
+		return "", fmt.Errorf("failed to create author %q: %w", name, err)
+	}
+	im.authors[nameKey(name)] = []string{author.AuthorId}
+	report.CreatedAuthors++
+
+	return author.AuthorId, nil
+}
+
+func (im *Importer) categoryID(ctx context.Context, name string, report *Report) (string, error) {
+	if ids := im.categories[nameKey(name)]; len(ids) > 0 {
+		return ids[0], nil
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return "", err
+	}
+
+	category, err := im.storage.Category().CreateCategory(ctx, pb.Category{CategoryId: id.String(), Name: name})
+	if err != nil {
+		return "", fmt.Errorf("failed to create category %q: %w", name, err)
+	}
+	im.categories[nameKey(name)] = []string{category.CategoryId}
+	report.CreatedCategories++
+
+	return category.CategoryId, nil
+}
+
+// loadNames indexes ids of every author and category by name
+func (im *Importer) loadNames(ctx context.Context) error {
+	_, count, err := im.storage.Author().ListAuthor(ctx, 1, 1)
+	if err != nil {
+		return err
+	}
+	authors, _, err := im.storage.Author().ListAuthor(ctx, 1, count)
+	if err != nil {
+		return err
+	}
+
+	im.authors = map[string][]string{}
+	for _, author := range authors {
+		key := nameKey(author.Name)
+		im.authors[key] = append(im.authors[key], author.AuthorId)
+	}
+
+	_, count, err = im.storage.Category().ListCategory(ctx, 1, 1)
+	if err != nil {
+		return err
+	}
+	categories, _, err := im.storage.Category().ListCategory(ctx, 1, count)
+	if err != nil {
+		return err
+	}
+
+	im.categories = map[string][]string{}
+	for _, category := range categories {
+		key := nameKey(category.Name)
+		im.categories[key] = append(im.categories[key], category.CategoryId)
+	}
+
+	return nil
+}
+
+// uniqueNames drops names repeating earlier ones
+func uniqueNames(names []string) []string {
+	var (
+		unique []string
+		seen   = map[string]bool{}
+	)
+	for _, name := range names {
+		if key := nameKey(name); !seen[key] {
+			seen[key] = true
+			unique = append(unique, strings.TrimSpace(name))
+		}
+	}
+
+	return unique
+}
+
+// nameKey makes name lookups ignore case and surrounding spaces
+func nameKey(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
@@ -0,0 +1,228 @@
+package importer
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	"github.com/abdullohsattorov/catalog-service/storage"
+	"github.com/abdullohsattorov/catalog-service/storage/repo"
+)
+
+// fakeCatalog keeps the books, authors and categories an import reads and
+// writes in memory
+type fakeCatalog struct {
+	storage.IStorage
+	books      []*pb.Book
+	authors    []*pb.Author
+	categories []*pb.Category
+
+	created []pb.Book
+	updates []pb.UpdateBookReq
+}
+
+type fakeBooks struct {
+	repo.BookStorageI
+	c *fakeCatalog
+}
+
+type fakeAuthors struct {
+	repo.AuthorStorageI
+	c *fakeCatalog
+}
+
+type fakeCategories struct {
+	repo.CategoryStorageI
+	c *fakeCatalog
+}
+
+func (c *fakeCatalog) Book() repo.BookStorageI {
+	return fakeBooks{c: c}
+}
+
+func (c *fakeCatalog) Author() repo.AuthorStorageI {
+	return fakeAuthors{c: c}
+}
+
+func (c *fakeCatalog) Category() repo.CategoryStorageI {
+	return fakeCategories{c: c}
+}
+
+func (b fakeBooks) FindBooksByIdentifiers(ctx context.Context, isbn, externalID string) ([]*pb.Book, error) {
+	var found []*pb.Book
+	for _, book := range b.c.books {
+		if (isbn != "" && book.Isbn == isbn) || (externalID != "" && book.ExternalId == externalID) {
+			found = append(found, book)
+		}
+	}
+
+	return found, nil
+}
+
+func (b fakeBooks) CreateBook(ctx context.Context, book pb.Book) (pb.Book, error) {
+	b.c.created = append(b.c.created, book)
+	return book, nil
+}
+
+func (b fakeBooks) UpdateBook(ctx context.Context, update pb.UpdateBookReq) (pb.Book, error) {
+	b.c.updates = append(b.c.updates, update)
+	return *update.Book, nil
+}
+
+func (a fakeAuthors) ListAuthor(ctx context.Context, page, limit int64) ([]*pb.Author, int64, error) {
+	return a.c.authors, int64(len(a.c.authors)), nil
+}
+
+func (a fakeAuthors) CreateAuthor(ctx context.Context, author pb.Author) (pb.Author, error) {
+	a.c.authors = append(a.c.authors, &author)
+	return author, nil
+}
+
+func (c fakeCategories) ListCategory(ctx context.Context, page, limit int64) ([]*pb.Category, int64, error) {
+	return c.c.categories, int64(len(c.c.categories)), nil
+}
+
+func (c fakeCategories) CreateCategory(ctx context.Context, category pb.Category) (pb.Category, error) {
+	c.c.categories = append(c.c.categories, &category)
+	return category, nil
+}
+
+func newFakeCatalog() *fakeCatalog {
+	return &fakeCatalog{
+		books: []*pb.Book{{
+			BookId:     "b1",
+			Name:       "War and Peace",
+			AuthorId:   "a1",
+			Price:      12.5,
+			CategoryId: []string{"c1"},
+			Isbn:       "9780306406157",
+			Version:    3,
+		}},
+		authors:    []*pb.Author{{AuthorId: "a1", Name: "Tolstoy"}},
+		categories: []*pb.Category{{CategoryId: "c1", Name: "Novel"}},
+	}
+}
+
+func TestImport(t *testing.T) {
+	warAndPeace := Record{Row: 2, Isbn: "0-306-40615-2", Name: "War and Peace", Author: "tolstoy", Price: 12.5, HasPrice: true, Categories: []string{"Novel"}}
+
+	tests := []struct {
+		name    string
+		records []Record
+		opts    Options
+		report  Report
+		created int
+		// mask of the update of the existing book, nil when it isn't updated
+		mask []string
+	}{
+		{
+			name:    "record matching its book by normalized isbn",
+			records: []Record{warAndPeace},
+			report:  Report{Unchanged: 1, Applied: []int{2}},
+		},
+		{
+			name:    "record without a price matching its book",
+			records: []Record{withPrice(warAndPeace, 0, false)},
+			report:  Report{Unchanged: 1, Applied: []int{2}},
+		},
+		{
+			name:    "changed price",
+			records: []Record{withPrice(warAndPeace, 15, true)},
+			report:  Report{Updated: 1, Applied: []int{2}},
+			mask:    repo.BookFields,
+		},
+		{
+			name: "changed name without a price keeps the price",
+			records: []Record{func() Record {
+				r := withPrice(warAndPeace, 0, false)
+				r.Name = "War & Peace"
+				return r
+			}()},
+			report: Report{Updated: 1, Applied: []int{2}},
+			mask:   []string{repo.FieldName, repo.FieldAuthorID, repo.FieldCategoryID, repo.FieldIsbn, repo.FieldExternalID},
+		},
+		{
+			name:    "new book",
+			records: []Record{{Row: 2, ExternalID: "e1", Name: "Resurrection", Author: "Tolstoy"}},
+			report:  Report{Created: 1, Applied: []int{2}},
+			created: 1,
+		},
+		{
+			name:    "new book with missing names created",
+			records: []Record{{Row: 2, ExternalID: "e1", Name: "Uncle Vanya", Author: "Chekhov", Categories: []string{"Play"}}},
+			opts:    Options{CreateMissing: true},
+			report:  Report{Created: 1, CreatedAuthors: 1, CreatedCategories: 1, Applied: []int{2}},
+			created: 1,
+		},
+		{
+			name:    "dry run writes nothing",
+			records: []Record{withPrice(warAndPeace, 15, true), {Row: 3, ExternalID: "e1", Name: "Resurrection", Author: "Tolstoy"}},
+			opts:    Options{DryRun: true},
+			report:  Report{Created: 1, Updated: 1, DryRun: true},
+		},
+		{
+			name: "invalid records fail the import",
+			records: []Record{
+				{Row: 2, Isbn: "0-306-40615-3", Name: "War and Peace", Author: "Tolstoy"},
+				{Row: 3, ExternalID: "e1", Author: "Tolstoy"},
+				{Row: 4, ExternalID: "e2", Name: "Uncle Vanya", Author: "Chekhov"},
+				{Row: 5, ExternalID: "e3", Name: "Resurrection", Author: "Tolstoy", Price: -1, HasPrice: true},
+				{Row: 6, Name: "Resurrection", Author: "Tolstoy"},
+				{Row: 7, ExternalID: "e4", Name: "Childhood", Author: "Tolstoy"},
+				{Row: 8, ExternalID: "e4", Name: "Boyhood", Author: "Tolstoy"},
+			},
+			report: Report{
+				Created: 1,
+				Errors: []RowError{
+					{Row: 2, Message: `invalid isbn "0-306-40615-3"`},
+					{Row: 3, Message: "name is required"},
+					{Row: 4, Message: `author "Chekhov" not found`},
+					{Row: 5, Message: "price can't be negative"},
+					{Row: 6, Message: "isbn or external_id is required"},
+					{Row: 8, Message: "external_id e4 is already used on row 7"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalog := newFakeCatalog()
+
+			report, err := New(catalog, tt.opts).Import(context.Background(), tt.records, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(report, tt.report) {
+				t.Fatalf("got report %+v, want %+v", report, tt.report)
+			}
+			if len(catalog.created) != tt.created {
+				t.Fatalf("created %d books, want %d", len(catalog.created), tt.created)
+			}
+
+			if tt.mask == nil {
+				if len(catalog.updates) > 0 {
+					t.Fatalf("got updates %+v, want none", catalog.updates)
+				}
+				return
+			}
+			if len(catalog.updates) != 1 {
+				t.Fatalf("got %d updates, want 1", len(catalog.updates))
+			}
+			update := catalog.updates[0]
+			if !reflect.DeepEqual(update.UpdateMask.Paths, tt.mask) {
+				t.Fatalf("got mask %v, want %v", update.UpdateMask.Paths, tt.mask)
+			}
+			if update.Book.BookId != "b1" || update.Book.Version != 3 {
+				t.Fatalf("got update of book %s version %d, want b1 version 3", update.Book.BookId, update.Book.Version)
+			}
+		})
+	}
+}
+
+func withPrice(rec Record, price float32, hasPrice bool) Record {
+	rec.Price, rec.HasPrice = price, hasPrice
+	return rec
+}
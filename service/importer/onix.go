@@ -0,0 +1,315 @@
+package importer
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cast"
+)
+
+// ONIX 3.0 code lists used by the mapping
+const (
+	onixNotificationDelete = "05"
+
+	onixIDTypeISBN10 = "02"
+	onixIDTypeGTIN13 = "03"
+	onixIDTypeISBN13 = "15"
+
+	onixTitleTypeDistinctive = "01"
+	onixTitleLevelProduct    = "01"
+
+	onixRoleAuthor = "A01"
+
+	onixSchemeBISAC = "10"
+	onixSchemeThema = "93"
+
+	onixPriceRRPExclTax = "01"
+	onixPriceRRPInclTax = "02"
+)
+
+// UnmappedField counts values of an ONIX element the import doesn't use
+type UnmappedField struct {
+	Path  string
+	Count int
+}
+
+// onixNode is any ONIX element, the mapping marks the ones it uses
+type onixNode struct {
+	XMLName xml.Name
+	Text    string     `xml:",chardata"`
+	Nodes   []onixNode `xml:",any"`
+	used    bool
+}
+
+func (n *onixNode) children(name string) []*onixNode {
+	var found []*onixNode
+	for i := range n.Nodes {
+		if n.Nodes[i].XMLName.Local == name {
+			found = append(found, &n.Nodes[i])
+		}
+	}
+
+	return found
+}
+
+func (n *onixNode) child(name string) *onixNode {
+	if found := n.children(name); len(found) > 0 {
+		return found[0]
+	}
+
+	return nil
+}
+
+// text returns the trimmed text of the element at path and marks it used
+func (n *onixNode) text(path ...string) string {
+	node := n
+	for _, name := range path {
+		if node = node.child(name); node == nil {
+			return ""
+		}
+	}
+
+	node.used = true
+	return strings.TrimSpace(node.Text)
+}
+
+// unmapped counts leaf elements which weren't used, by path
+func (n *onixNode) unmapped(prefix string, counts map[string]int) {
+	path := n.XMLName.Local
+	if prefix != "" {
+		path = prefix + "/" + path
+	}
+	if len(n.Nodes) == 0 {
+		if !n.used && strings.TrimSpace(n.Text) != "" {
+			counts[path]++
+		}
+		return
+	}
+
+	for i := range n.Nodes {
+		n.Nodes[i].unmapped(path, counts)
+	}
+}
+
+// ReadONIX reads the products of an ONIX 3.0 feed with reference tags. The
+// first contributor with the author role becomes the author, BISAC and Thema
+// subjects become categories and the recommended retail price in currency
+// becomes the price. Elements which aren't mapped are counted by path.
+func ReadONIX(r io.Reader, currency string) ([]Record, []RowError, []UnmappedField, error) {
+	var (
+		decoder = xml.NewDecoder(r)
+		records []Record
+		errs    []RowError
+		counts  = map[string]int{}
+		n       int
+	)
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch start.Name.Local {
+		case "ONIXmessage":
+			return nil, nil, nil, errors.New("short tag ONIX isn't supported, send the feed with reference tags")
+		case "Product":
+		default:
+			continue
+		}
+
+		var product onixNode
+		if err = decoder.DecodeElement(&product, &start); err != nil {
+			return nil, nil, nil, err
+		}
+		n++
+
+		if product.text("NotificationType") == onixNotificationDelete {
+			counts["Product (delete notification, skipped)"]++
+			continue
+		}
+
+		rec, err := onixRecord(&product, currency)
+		rec.Row = n
+		if err != nil {
+			errs = append(errs, RowError{Row: n, Message: err.Error()})
+			continue
+		}
+		records = append(records, rec)
+
+		product.unmapped("", counts)
+	}
+
+	if n == 0 {
+		return nil, nil, nil, errors.New("feed has no products")
+	}
+
+	unmapped := make([]UnmappedField, 0, len(counts))
+	for path, count := range counts {
+		unmapped = append(unmapped, UnmappedField{Path: path, Count: count})
+	}
+	sort.Slice(unmapped, func(i, j int) bool {
+		return unmapped[i].Path < unmapped[j].Path
+	})
+
+	return records, errs, unmapped, nil
+}
+
+func onixRecord(product *onixNode, currency string) (Record, error) {
+	rec := Record{ExternalID: product.text("RecordReference")}
+
+	for _, id := range product.children("ProductIdentifier") {
+		switch id.text("ProductIDType") {
+		case onixIDTypeISBN13, onixIDTypeGTIN13:
+			rec.Isbn = id.text("IDValue")
+		case onixIDTypeISBN10:
+			if rec.Isbn == "" {
+				rec.Isbn = id.text("IDValue")
+			}
+		}
+	}
+
+	detail := product.child("DescriptiveDetail")
+	if detail == nil {
+		return rec, errors.New("DescriptiveDetail is missing")
+	}
+
+	rec.Name = onixTitle(detail)
+	rec.Author = onixAuthor(detail)
+	rec.Categories = onixSubjects(detail)
+
+	// products without a price in currency keep the price of their book
+	if supply := product.child("ProductSupply"); supply != nil {
+		var err error
+		if rec.Price, rec.HasPrice, err = onixPrice(supply, currency); err != nil {
+			return rec, err
+		}
+	}
+
+	return rec, nil
+}
+
+func onixTitle(detail *onixNode) string {
+	for _, title := range detail.children("TitleDetail") {
+		if title.text("TitleType") != onixTitleTypeDistinctive {
+			continue
+		}
+
+		for _, element := range title.children("TitleElement") {
+			if element.text("TitleElementLevel") != onixTitleLevelProduct {
+				continue
+			}
+
+			if text := element.text("TitleText"); text != "" {
+				return text
+			}
+
+			return strings.TrimSpace(element.text("TitlePrefix") + " " + element.text("TitleWithoutPrefix"))
+		}
+	}
+
+	return ""
+}
+
+// onixAuthor returns the name of the first author by sequence number, the
+// catalog keeps one author per book
+func onixAuthor(detail *onixNode) string {
+	var (
+		author *onixNode
+		first  int
+	)
+
+	for _, contributor := range detail.children("Contributor") {
+		isAuthor := false
+		for _, role := range contributor.children("ContributorRole") {
+			role.used = true
+			if strings.TrimSpace(role.Text) == onixRoleAuthor {
+				isAuthor = true
+			}
+		}
+		if !isAuthor {
+			continue
+		}
+
+		seq := cast.ToInt(contributor.text("SequenceNumber"))
+		if author == nil || seq < first {
+			author, first = contributor, seq
+		}
+	}
+
+	switch {
+	case author == nil:
+		return ""
+	case author.child("PersonName") != nil:
+		return author.text("PersonName")
+	case author.child("KeyNames") != nil:
+		return strings.TrimSpace(author.text("NamesBeforeKey") + " " + author.text("KeyNames"))
+	default:
+		return author.text("CorporateName")
+	}
+}
+
+// onixSubjects returns headings of BISAC and Thema subjects, or their codes
+// when the feed has no headings
+func onixSubjects(detail *onixNode) []string {
+	var categories []string
+	for _, subject := range detail.children("Subject") {
+		switch subject.text("SubjectSchemeIdentifier") {
+		case onixSchemeBISAC, onixSchemeThema:
+		default:
+			continue
+		}
+
+		name := subject.text("SubjectHeadingText")
+		if code := subject.text("SubjectCode"); name == "" {
+			name = code
+		}
+		if name != "" {
+			categories = append(categories, name)
+		}
+	}
+
+	return categories
+}
+
+// onixPrice returns the recommended retail price in currency and whether
+// there is one, prices without a currency code are taken as being in it
+func onixPrice(supply *onixNode, currency string) (float32, bool, error) {
+	for _, detail := range supply.children("SupplyDetail") {
+		for _, price := range detail.children("Price") {
+			switch price.text("PriceType") {
+			case onixPriceRRPExclTax, onixPriceRRPInclTax:
+			default:
+				continue
+			}
+
+			code := price.child("CurrencyCode")
+			if code != nil && !strings.EqualFold(strings.TrimSpace(code.Text), currency) {
+				continue
+			}
+			price.text("CurrencyCode")
+
+			amount := price.text("PriceAmount")
+			value, err := cast.ToFloat32E(amount)
+			if err != nil {
+				return 0, false, fmt.Errorf("invalid PriceAmount %q", amount)
+			}
+
+			return value, true, nil
+		}
+	}
+
+	return 0, false, nil
+}
@@ -0,0 +1,198 @@
+package importer
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const onixProduct = `
+<Product>
+	<RecordReference>com.example.1</RecordReference>
+	<NotificationType>03</NotificationType>
+	<ProductIdentifier>
+		<ProductIDType>02</ProductIDType>
+		<IDValue>0306406152</IDValue>
+	</ProductIdentifier>
+	<ProductIdentifier>
+		<ProductIDType>15</ProductIDType>
+		<IDValue>9780306406157</IDValue>
+	</ProductIdentifier>
+	<DescriptiveDetail>
+		<TitleDetail>
+			<TitleType>01</TitleType>
+			<TitleElement>
+				<TitleElementLevel>01</TitleElementLevel>
+				<TitlePrefix>The</TitlePrefix>
+				<TitleWithoutPrefix>Cossacks</TitleWithoutPrefix>
+			</TitleElement>
+		</TitleDetail>
+		<Contributor>
+			<SequenceNumber>2</SequenceNumber>
+			<ContributorRole>A01</ContributorRole>
+			<PersonName>Aylmer Maude</PersonName>
+		</Contributor>
+		<Contributor>
+			<SequenceNumber>1</SequenceNumber>
+			<ContributorRole>B06</ContributorRole>
+			<ContributorRole>A01</ContributorRole>
+			<NamesBeforeKey>Leo</NamesBeforeKey>
+			<KeyNames>Tolstoy</KeyNames>
+		</Contributor>
+		<Subject>
+			<SubjectSchemeIdentifier>10</SubjectSchemeIdentifier>
+			<SubjectCode>FIC019000</SubjectCode>
+			<SubjectHeadingText>Fiction / Literary</SubjectHeadingText>
+		</Subject>
+		<Subject>
+			<SubjectSchemeIdentifier>93</SubjectSchemeIdentifier>
+			<SubjectCode>FBC</SubjectCode>
+		</Subject>
+		<Subject>
+			<SubjectSchemeIdentifier>20</SubjectSchemeIdentifier>
+			<SubjectHeadingText>Caucasus</SubjectHeadingText>
+		</Subject>
+	</DescriptiveDetail>
+	%s
+</Product>`
+
+func onixFeed(supply string) string {
+	return "<ONIXMessage><Header><Sender>x</Sender></Header>" +
+		strings.Replace(onixProduct, "%s", supply, 1) + "</ONIXMessage>"
+}
+
+func onixSupply(prices ...string) string {
+	return "<ProductSupply><SupplyDetail>" + strings.Join(prices, "") + "</SupplyDetail></ProductSupply>"
+}
+
+func onixPriceOf(priceType, currency, amount string) string {
+	price := "<Price><PriceType>" + priceType + "</PriceType><PriceAmount>" + amount + "</PriceAmount>"
+	if currency != "" {
+		price += "<CurrencyCode>" + currency + "</CurrencyCode>"
+	}
+
+	return price + "</Price>"
+}
+
+func TestReadONIX(t *testing.T) {
+	cossacks := Record{
+		Row:        1,
+		Isbn:       "9780306406157",
+		ExternalID: "com.example.1",
+		Name:       "The Cossacks",
+		Author:     "Leo Tolstoy",
+		Categories: []string{"Fiction / Literary", "FBC"},
+	}
+	priced := func(price float32) Record {
+		rec := cossacks
+		rec.Price, rec.HasPrice = price, true
+		return rec
+	}
+
+	tests := []struct {
+		name   string
+		feed   string
+		record Record
+		errs   []RowError
+	}{
+		{
+			name: "price in the currency",
+			feed: onixFeed(onixSupply(
+				onixPriceOf("02", "GBP", "8.99"),
+				onixPriceOf("02", "USD", "10.50"),
+			)),
+			record: priced(10.5),
+		},
+		{
+			name:   "price without a currency code",
+			feed:   onixFeed(onixSupply(onixPriceOf("01", "", "9"))),
+			record: priced(9),
+		},
+		{
+			name: "only retail prices",
+			feed: onixFeed(onixSupply(
+				onixPriceOf("05", "USD", "4"),
+				onixPriceOf("01", "usd", "11"),
+			)),
+			record: priced(11),
+		},
+		{
+			name:   "no price in the currency",
+			feed:   onixFeed(onixSupply(onixPriceOf("02", "GBP", "8.99"))),
+			record: cossacks,
+		},
+		{
+			name:   "no supply",
+			feed:   onixFeed(""),
+			record: cossacks,
+		},
+		{
+			name: "invalid price",
+			feed: onixFeed(onixSupply(onixPriceOf("02", "USD", "ten"))),
+			errs: []RowError{{Row: 1, Message: `invalid PriceAmount "ten"`}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records, errs, _, err := ReadONIX(strings.NewReader(tt.feed), "USD")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(errs, tt.errs) {
+				t.Fatalf("got errors %+v, want %+v", errs, tt.errs)
+			}
+			if tt.errs != nil {
+				return
+			}
+			if len(records) != 1 || !reflect.DeepEqual(records[0], tt.record) {
+				t.Fatalf("got records %+v, want %+v", records, tt.record)
+			}
+		})
+	}
+}
+
+func TestReadONIXUnmapped(t *testing.T) {
+	feed := "<ONIXMessage>" +
+		strings.Replace(onixProduct, "%s", "", 1) +
+		"<Product><RecordReference>com.example.2</RecordReference><NotificationType>05</NotificationType></Product>" +
+		"</ONIXMessage>"
+
+	records, _, unmapped, err := ReadONIX(strings.NewReader(feed), "USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("got %d records, want the deleted product skipped", len(records))
+	}
+
+	want := []UnmappedField{
+		{Path: "Product (delete notification, skipped)", Count: 1},
+		// the second author and the heading of a subject of another scheme
+		{Path: "Product/DescriptiveDetail/Contributor/PersonName", Count: 1},
+		{Path: "Product/DescriptiveDetail/Subject/SubjectHeadingText", Count: 1},
+	}
+	if !reflect.DeepEqual(unmapped, want) {
+		t.Fatalf("got unmapped %+v, want %+v", unmapped, want)
+	}
+}
+
+func TestReadONIXBrokenFeeds(t *testing.T) {
+	tests := []struct {
+		name string
+		feed string
+	}{
+		{name: "short tags", feed: "<ONIXmessage><product></product></ONIXmessage>"},
+		{name: "no products", feed: "<ONIXMessage><Header></Header></ONIXMessage>"},
+		{name: "invalid xml", feed: "<ONIXMessage><Product>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, _, err := ReadONIX(strings.NewReader(tt.feed), "USD"); err == nil {
+				t.Fatal("got no error")
+			}
+		})
+	}
+}
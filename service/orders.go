@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	orderPb "github.com/abdullohsattorov/catalog-service/genproto/order_service"
+	l "github.com/abdullohsattorov/catalog-service/pkg/logger"
+)
+
+const (
+	ordersPageLimit = 100
+	// maxOrderPages bounds the orders looked through. order_service can't
+	// list the orders of one book, so every order is paged through.
+	maxOrderPages = 10
+)
+
+var errTooManyOrders = errors.New("too many orders to look through")
+
+// checkOpenOrders refuses to delete a book open orders still reference,
+// unless the delete is forced. Deletes are refused too when the orders
+// can't be checked.
+func (s *CatalogService) checkOpenOrders(ctx context.Context, req *pb.DeleteBookReq) error {
+	if req.Force {
+		s.log(ctx).Warn("deleting book without checking open orders", l.String("book_id", req.Id))
+		return nil
+	}
+
+	hasOrders, err := s.hasOpenOrders(ctx, req.Id)
+	if errors.Is(err, errTooManyOrders) {
+		return status.Error(codes.FailedPrecondition, "book has too many orders to check, force the delete to skip the check")
+	}
+	if err != nil {
+		s.log(ctx).Error("failed to check open orders of book", l.Error(err))
+		return status.Error(codes.Unavailable, "failed to check open orders of book")
+	}
+
+	if hasOrders {
+		return status.Error(codes.FailedPrecondition, "book is referenced by open orders")
+	}
+
+	return nil
+}
+
+// hasOpenOrders looks for orders of the book in order_service. order_service
+// keeps no order status, every order it still lists is open. When there are
+// more orders than maxOrderPages it fails instead of guessing.
+func (s *CatalogService) hasOpenOrders(ctx context.Context, bookID string) (bool, error) {
+	for page := int64(1); page <= maxOrderPages; page++ {
+		resp, err := s.client.OrderService().List(ctx, &orderPb.ListReq{
+			Page:  page,
+			Limit: ordersPageLimit,
+		})
+		if err != nil {
+			return false, err
+		}
+
+		for _, order := range resp.Orders {
+			if order.BookId == bookID {
+				return true, nil
+			}
+		}
+
+		if len(resp.Orders) < ordersPageLimit || page*ordersPageLimit >= resp.Count {
+			return false, nil
+		}
+	}
+
+	return false, errTooManyOrders
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	orderPb "github.com/abdullohsattorov/catalog-service/genproto/order_service"
+	reviewPb "github.com/abdullohsattorov/catalog-service/genproto/review_service"
+	l "github.com/abdullohsattorov/catalog-service/pkg/logger"
+)
+
+// fakeOrderService lists orders from memory, a page at a time like
+// order_service does
+type fakeOrderService struct {
+	orderPb.OrderServiceClient
+	orders []*orderPb.OrderResp
+	err    error
+	calls  int
+}
+
+func (f *fakeOrderService) List(ctx context.Context, in *orderPb.ListReq, opts ...grpc.CallOption) (*orderPb.ListResp, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	orders := f.orders
+	count := int64(len(orders))
+
+	start := (in.Page - 1) * in.Limit
+	if start > count {
+		start = count
+	}
+	end := start + in.Limit
+	if end > count {
+		end = count
+	}
+
+	return &orderPb.ListResp{Orders: orders[start:end], Count: count}, nil
+}
+
+// fakeGrpcClient hands out in-process fakes instead of dialing
+type fakeGrpcClient struct {
+	orders  orderPb.OrderServiceClient
+	reviews reviewPb.ReviewServiceClient
+}
+
+func (c fakeGrpcClient) OrderService() orderPb.OrderServiceClient {
+	return c.orders
+}
+
+func (c fakeGrpcClient) ReviewService() reviewPb.ReviewServiceClient {
+	return c.reviews
+}
+
+func (c fakeGrpcClient) States() map[string]connectivity.State {
+	return nil
+}
+
+func (c fakeGrpcClient) Close() error {
+	return nil
+}
+
+func newOrdersTestService(orders orderPb.OrderServiceClient) *CatalogService {
+	return &CatalogService{
+		logger: l.New(l.LevelError, "test"),
+		client: fakeGrpcClient{orders: orders},
+	}
+}
+
+func TestCheckOpenOrders(t *testing.T) {
+	orders := []*orderPb.OrderResp{
+		{OrderId: "o1", BookId: "b1"},
+		{OrderId: "o2", BookId: "b2"},
+	}
+
+	// the order of b4 is on the second page
+	paged := append(ordersOf("other", ordersPageLimit), &orderPb.OrderResp{OrderId: "o4", BookId: "b4"})
+
+	tests := []struct {
+		name   string
+		orders []*orderPb.OrderResp
+		req    *pb.DeleteBookReq
+		err    error
+		code   codes.Code
+		calls  int
+	}{
+		{
+			name:  "open order blocks the delete",
+			req:   &pb.DeleteBookReq{Id: "b1"},
+			code:  codes.FailedPrecondition,
+			calls: 1,
+		},
+		{
+			name:  "book without orders",
+			req:   &pb.DeleteBookReq{Id: "b3"},
+			code:  codes.OK,
+			calls: 1,
+		},
+		{
+			name:   "open order on a later page",
+			orders: paged,
+			req:    &pb.DeleteBookReq{Id: "b4"},
+			code:   codes.FailedPrecondition,
+			calls:  2,
+		},
+		{
+			name:   "every page looked through",
+			orders: paged,
+			req:    &pb.DeleteBookReq{Id: "b3"},
+			code:   codes.OK,
+			calls:  2,
+		},
+		{
+			name:  "force skips the check",
+			req:   &pb.DeleteBookReq{Id: "b1", Force: true},
+			code:  codes.OK,
+			calls: 0,
+		},
+		{
+			name:  "order_service failing refuses the delete",
+			req:   &pb.DeleteBookReq{Id: "b3"},
+			err:   status.Error(codes.Unavailable, "connection refused"),
+			code:  codes.Unavailable,
+			calls: 1,
+		},
+		{
+			name:  "force skips a failing order_service",
+			req:   &pb.DeleteBookReq{Id: "b3", Force: true},
+			err:   status.Error(codes.Unavailable, "connection refused"),
+			code:  codes.OK,
+			calls: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeOrderService{orders: orders, err: tt.err}
+			if tt.orders != nil {
+				fake.orders = tt.orders
+			}
+			s := newOrdersTestService(fake)
+
+			err := s.checkOpenOrders(context.Background(), tt.req)
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("got code %s (%v), want %s", code, err, tt.code)
+			}
+			if fake.calls != tt.calls {
+				t.Fatalf("order_service was called %d times, want %d", fake.calls, tt.calls)
+			}
+		})
+	}
+}
+
+func TestCheckOpenOrdersFailsClosedWithTooManyOrders(t *testing.T) {
+	// with more orders than the check looks through, the book may have open
+	// orders on the pages left, the delete is refused
+	fake := &fakeOrderService{orders: ordersOf("other", maxOrderPages*ordersPageLimit+1)}
+	s := newOrdersTestService(fake)
+
+	err := s.checkOpenOrders(context.Background(), &pb.DeleteBookReq{Id: "b1"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("got %v, want FailedPrecondition", err)
+	}
+	if fake.calls != maxOrderPages {
+		t.Fatalf("order_service was called %d times, want %d", fake.calls, maxOrderPages)
+	}
+}
+
+func ordersOf(bookID string, n int) []*orderPb.OrderResp {
+	orders := make([]*orderPb.OrderResp, n)
+	for i := range orders {
+		orders[i] = &orderPb.OrderResp{BookId: bookID}
+	}
+
+	return orders
+}
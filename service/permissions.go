@@ -0,0 +1,43 @@
+package service
+
+import "github.com/abdullohsattorov/catalog-service/pkg/auth"
+
+// Permissions is the role each method requires. Readers can read the
+// catalog, editors can change it and admins can delete from it and read
+// the audit log. Health checks and reflection are public.
+var Permissions = auth.Permissions{
+	"/grpc.health.v1.Health/":                    auth.Public,
+	"/grpc.reflection.v1alpha.ServerReflection/": auth.Public,
+
+	"/catalog.CatalogService/GetCategory":        auth.Reader,
+	"/catalog.CatalogService/ListCategory":       auth.Reader,
+	"/catalog.CatalogService/GetAuthor":          auth.Reader,
+	"/catalog.CatalogService/ListAuthor":         auth.Reader,
+	"/catalog.CatalogService/GetBook":            auth.Reader,
+	"/catalog.CatalogService/ListBook":           auth.Reader,
+	"/catalog.CatalogService/WatchCatalog":       auth.Reader,
+	"/catalog.CatalogService/DiffBookVersions":   auth.Reader,
+	"/catalog.CatalogService/BatchGetBooks":      auth.Reader,
+	"/catalog.CatalogService/BatchGetAuthors":    auth.Reader,
+	"/catalog.CatalogService/BatchGetCategories": auth.Reader,
+	"/catalog.CatalogService/ExportCatalog":      auth.Reader,
+	"/catalog.CatalogService/ExportMarc":         auth.Reader,
+
+	"/catalog.CatalogService/CreateCategory":   auth.Editor,
+	"/catalog.CatalogService/UpdateCategory":   auth.Editor,
+	"/catalog.CatalogService/UpsertCategory":   auth.Editor,
+	"/catalog.CatalogService/CreateAuthor":     auth.Editor,
+	"/catalog.CatalogService/UpdateAuthor":     auth.Editor,
+	"/catalog.CatalogService/UpsertAuthor":     auth.Editor,
+	"/catalog.CatalogService/CreateBook":       auth.Editor,
+	"/catalog.CatalogService/UpdateBook":       auth.Editor,
+	"/catalog.CatalogService/UpsertBook":       auth.Editor,
+	"/catalog.CatalogService/BatchCreateBooks": auth.Editor,
+	"/catalog.CatalogService/BatchUpdateBooks": auth.Editor,
+	"/catalog.CatalogService/ImportCatalog":    auth.Editor,
+
+	"/catalog.CatalogService/DeleteCategory":  auth.Admin,
+	"/catalog.CatalogService/DeleteAuthor":    auth.Admin,
+	"/catalog.CatalogService/DeleteBook":      auth.Admin,
+	"/catalog.CatalogService/ListAuditEvents": auth.Admin,
+}
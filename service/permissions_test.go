@@ -0,0 +1,76 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang-jwt/jwt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	"github.com/abdullohsattorov/catalog-service/pkg/auth"
+)
+
+// TestPermissionsCoverService keeps new methods from being uncallable, since
+// methods Permissions doesn't list are denied
+func TestPermissionsCoverService(t *testing.T) {
+	s := grpc.NewServer()
+	pb.RegisterCatalogServiceServer(s, (*CatalogService)(nil))
+
+	for name, info := range s.GetServiceInfo() {
+		for _, method := range info.Methods {
+			fullMethod := "/" + name + "/" + method.Name
+			if _, ok := Permissions[fullMethod]; !ok {
+				t.Errorf("%s has no permission", fullMethod)
+			}
+		}
+	}
+}
+
+// TestPermissionsRoleOrder checks every role may call the methods of the
+// roles below it, and none of the ones above
+func TestPermissionsRoleOrder(t *testing.T) {
+	const secret = "secret"
+	a, err := auth.New(auth.Config{HMACSecret: secret})
+	if err != nil {
+		t.Fatal(err)
+	}
+	interceptor := a.UnaryServerInterceptor(Permissions)
+
+	roles := map[auth.Role]string{
+		auth.Reader: "reader",
+		auth.Editor: "editor",
+		auth.Admin:  "admin",
+	}
+	for role, name := range roles {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"sub":   "user-1",
+			"roles": []string{name},
+		}).SignedString([]byte(secret))
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+
+		for method, required := range Permissions {
+			if required == auth.Public {
+				continue
+			}
+
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, nil
+			})
+
+			want := codes.OK
+			if role < required {
+				want = codes.PermissionDenied
+			}
+			if code := status.Code(err); code != want {
+				t.Errorf("%s calling %s: got code %s (%v), want %s", role, method, code, err, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package service
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/spf13/cast"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	reviewPb "github.com/abdullohsattorov/catalog-service/genproto/review_service"
+	l "github.com/abdullohsattorov/catalog-service/pkg/logger"
+)
+
+// ListBook filters handled by the service instead of the storage
+const (
+	filterMinRating = "min_rating"
+	filterMaxRating = "max_rating"
+	filterSort      = "sort"
+
+	sortRatingAsc  = "rating"
+	sortRatingDesc = "-rating"
+)
+
+// maxRatingCandidates bounds the books filtered or sorted by rating. They
+// are all loaded with their ratings, so larger lists have to be narrowed
+// by other filters first.
+const maxRatingCandidates = 1000
+
+var errInvalidSort = errors.New("sort must be either rating or -rating")
+
+// maxCachedRatings bounds the ratings kept in memory
+const maxCachedRatings = 10000
+
+type cachedRating struct {
+	rating    reviewPb.BookRating
+	expiresAt time.Time
+}
+
+// ratingCache keeps book ratings fetched from review_service for ttl. Every
+// rating lives as long, so the order they were set in is the order they
+// expire in: expired ones are dropped from the front of the list whenever a
+// rating is set, and the oldest ones once there are too many.
+type ratingCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	size    int
+	order   *list.List
+	ratings map[string]*list.Element
+}
+
+func newRatingCache(ttl time.Duration) *ratingCache {
+	return &ratingCache{
+		ttl:     ttl,
+		size:    maxCachedRatings,
+		order:   list.New(),
+		ratings: map[string]*list.Element{},
+	}
+}
+
+// get returns the cached ratings and the ids which have to be fetched
+func (c *ratingCache) get(ids []string) (map[string]reviewPb.BookRating, []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var (
+		now     = time.Now()
+		found   = make(map[string]reviewPb.BookRating, len(ids))
+		missing []string
+	)
+	for _, id := range ids {
+		elem, ok := c.ratings[id]
+		if !ok || now.After(elem.Value.(cachedRating).expiresAt) {
+			missing = append(missing, id)
+			continue
+		}
+		found[id] = elem.Value.(cachedRating).rating
+	}
+
+	return found, missing
+}
+
+func (c *ratingCache) set(rating reviewPb.BookRating) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if elem, ok := c.ratings[rating.BookId]; ok {
+		c.order.Remove(elem)
+	}
+	c.ratings[rating.BookId] = c.order.PushBack(cachedRating{
+		rating:    rating,
+		expiresAt: now.Add(c.ttl),
+	})
+
+	for front := c.order.Front(); front != nil; front = c.order.Front() {
+		cached := front.Value.(cachedRating)
+		if len(c.ratings) <= c.size && !now.After(cached.expiresAt) {
+			break
+		}
+		c.order.Remove(front)
+		delete(c.ratings, cached.rating.BookId)
+	}
+}
+
+// bookRatings returns ratings of the books, asking review_service only for
+// the ones missing in the cache. Books without reviews get a zero rating.
+func (s *CatalogService) bookRatings(ctx context.Context, ids []string) (map[string]reviewPb.BookRating, error) {
+	ratings, missing := s.ratings.get(ids)
+	if len(missing) == 0 {
+		return ratings, nil
+	}
+
+	resp, err := s.client.ReviewService().GetBookRatings(ctx, &reviewPb.BookIdsReq{BookIds: missing})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range missing {
+		ratings[id] = reviewPb.BookRating{BookId: id}
+	}
+	for _, rating := range resp.Ratings {
+		ratings[rating.BookId] = *rating
+	}
+	for _, id := range missing {
+		s.ratings.set(ratings[id])
+	}
+
+	return ratings, nil
+}
+
+// attachRatings fills ratings of the books. Books are still returned without
+// ratings when review_service is down.
+func (s *CatalogService) attachRatings(ctx context.Context, books ...*pb.Book) {
+	if len(books) == 0 {
+		return
+	}
+
+	ids := make([]string, 0, len(books))
+	for _, book := range books {
+		ids = append(ids, book.BookId)
+	}
+
+	ratings, err := s.bookRatings(ctx, ids)
+	if err != nil {
+		s.log(ctx).Warn("failed to get book ratings, returning books without them", l.Error(err))
+		return
+	}
+
+	for _, book := range books {
+		book.AverageRating = ratings[book.BookId].AverageRating
+		book.ReviewCount = ratings[book.BookId].ReviewCount
+	}
+}
+
+type ratingFilter struct {
+	min, max float32
+	sort     string
+}
+
+func parseRatingFilter(filters map[string]string) (ratingFilter, bool, error) {
+	f := ratingFilter{max: -1}
+	_, hasMin := filters[filterMinRating]
+	_, hasMax := filters[filterMaxRating]
+	sortBy, hasSort := filters[filterSort]
+
+	if !hasMin && !hasMax && !hasSort {
+		return f, false, nil
+	}
+
+	var err error
+	if hasMin {
+		if f.min, err = cast.ToFloat32E(filters[filterMinRating]); err != nil {
+			return f, true, err
+		}
+	}
+	if hasMax {
+		if f.max, err = cast.ToFloat32E(filters[filterMaxRating]); err != nil {
+			return f, true, err
+		}
+	}
+	if hasSort {
+		if sortBy != sortRatingAsc && sortBy != sortRatingDesc {
+			return f, true, errInvalidSort
+		}
+		f.sort = sortBy
+	}
+
+	return f, true, nil
+}
+
+func (f ratingFilter) match(book *pb.Book) bool {
+	return book.AverageRating >= f.min && (f.max < 0 || book.AverageRating <= f.max)
+}
+
+// apply filters and sorts the books by rating, then cuts the requested page
+func (f ratingFilter) apply(books []*pb.Book, page, limit int64) ([]*pb.Book, int64) {
+	matched := books[:0]
+	for _, book := range books {
+		if f.match(book) {
+			matched = append(matched, book)
+		}
+	}
+
+	switch f.sort {
+	case sortRatingAsc:
+		sort.SliceStable(matched, func(i, j int) bool {
+			return matched[i].AverageRating < matched[j].AverageRating
+		})
+	case sortRatingDesc:
+		sort.SliceStable(matched, func(i, j int) bool {
+			return matched[i].AverageRating > matched[j].AverageRating
+		})
+	}
+
+	count := int64(len(matched))
+	offset := (page - 1) * limit
+	if offset < 0 || offset >= count {
+		return nil, count
+	}
+
+	end := offset + limit
+	if end > count {
+		end = count
+	}
+
+	return matched[offset:end], count
+}
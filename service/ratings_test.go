@@ -0,0 +1,303 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	reviewPb "github.com/abdullohsattorov/catalog-service/genproto/review_service"
+	l "github.com/abdullohsattorov/catalog-service/pkg/logger"
+	"github.com/abdullohsattorov/catalog-service/storage/repo"
+)
+
+// fakeReviewService rates the books it knows, and records the ids each call
+// asks for
+type fakeReviewService struct {
+	reviewPb.ReviewServiceClient
+	ratings map[string]float32
+	err     error
+	asked   [][]string
+}
+
+func (f *fakeReviewService) GetBookRatings(ctx context.Context, in *reviewPb.BookIdsReq, opts ...grpc.CallOption) (*reviewPb.BookRatingsResp, error) {
+	f.asked = append(f.asked, in.BookIds)
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	resp := &reviewPb.BookRatingsResp{}
+	for _, id := range in.BookIds {
+		if rating, ok := f.ratings[id]; ok {
+			resp.Ratings = append(resp.Ratings, &reviewPb.BookRating{BookId: id, AverageRating: rating, ReviewCount: 1})
+		}
+	}
+
+	return resp, nil
+}
+
+// listedBooks lists the books in memory, ignoring the filters
+type listedBooks struct {
+	repo.BookStorageI
+	books []*pb.Book
+}
+
+func (b listedBooks) ListBook(ctx context.Context, page, limit int64, filters map[string]string) ([]*pb.Book, int64, error) {
+	count := int64(len(b.books))
+	start, end := (page-1)*limit, page*limit
+	if start > count {
+		start = count
+	}
+	if end > count {
+		end = count
+	}
+
+	books := make([]*pb.Book, 0, end-start)
+	for _, book := range b.books[start:end] {
+		copied := *book
+		books = append(books, &copied)
+	}
+
+	return books, count, nil
+}
+
+func newRatingsTestService(books []*pb.Book, reviews *fakeReviewService) *CatalogService {
+	return &CatalogService{
+		storage: fakeStorage{book: listedBooks{books: books}},
+		logger:  l.New(l.LevelError, "test"),
+		client:  fakeGrpcClient{reviews: reviews},
+		ratings: newRatingCache(time.Minute),
+	}
+}
+
+func TestRatingCache(t *testing.T) {
+	c := newRatingCache(time.Minute)
+	c.size = 2
+
+	c.set(reviewPb.BookRating{BookId: "b1", AverageRating: 4})
+	c.set(reviewPb.BookRating{BookId: "b2", AverageRating: 3})
+	found, missing := c.get([]string{"b1", "b2", "b3"})
+	if len(found) != 2 || found["b1"].AverageRating != 4 || !reflect.DeepEqual(missing, []string{"b3"}) {
+		t.Fatalf("got ratings %v and missing %v, want b1 and b2 found", found, missing)
+	}
+
+	// setting b1 again makes b2 the oldest, which is dropped once there are
+	// too many ratings
+	c.set(reviewPb.BookRating{BookId: "b1", AverageRating: 5})
+	c.set(reviewPb.BookRating{BookId: "b3", AverageRating: 2})
+	found, missing = c.get([]string{"b1", "b2", "b3"})
+	if len(found) != 2 || found["b1"].AverageRating != 5 || !reflect.DeepEqual(missing, []string{"b2"}) {
+		t.Fatalf("got ratings %v and missing %v, want b2 dropped", found, missing)
+	}
+	if c.order.Len() != len(c.ratings) {
+		t.Fatalf("got %d ratings in order and %d by id", c.order.Len(), len(c.ratings))
+	}
+}
+
+func TestRatingCacheExpires(t *testing.T) {
+	c := newRatingCache(10 * time.Millisecond)
+
+	c.set(reviewPb.BookRating{BookId: "b1", AverageRating: 4})
+	if found, _ := c.get([]string{"b1"}); len(found) != 1 {
+		t.Fatal("rating wasn't cached")
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	if _, missing := c.get([]string{"b1"}); !reflect.DeepEqual(missing, []string{"b1"}) {
+		t.Fatalf("got missing %v, want the expired b1", missing)
+	}
+
+	// expired ratings are dropped when another one is set
+	c.set(reviewPb.BookRating{BookId: "b2", AverageRating: 3})
+	if _, ok := c.ratings["b1"]; ok || c.order.Len() != 1 {
+		t.Fatalf("got %d ratings, want the expired b1 dropped", c.order.Len())
+	}
+}
+
+func TestBookRatingsAreCached(t *testing.T) {
+	reviews := &fakeReviewService{ratings: map[string]float32{"b1": 4.5}}
+	s := newRatingsTestService(nil, reviews)
+	ctx := context.Background()
+
+	if _, err := s.bookRatings(ctx, []string{"b1", "b2"}); err != nil {
+		t.Fatal(err)
+	}
+	ratings, err := s.bookRatings(ctx, []string{"b1", "b2", "b3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// books without reviews are cached with a zero rating too
+	if want := [][]string{{"b1", "b2"}, {"b3"}}; !reflect.DeepEqual(reviews.asked, want) {
+		t.Fatalf("review_service was asked for %v, want %v", reviews.asked, want)
+	}
+	if ratings["b1"].AverageRating != 4.5 || ratings["b2"].AverageRating != 0 || ratings["b3"].BookId != "b3" {
+		t.Fatalf("got ratings %v", ratings)
+	}
+}
+
+func TestParseRatingFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters map[string]string
+		filter  ratingFilter
+		ok      bool
+		failed  bool
+	}{
+		{
+			name:    "no rating filters",
+			filters: map[string]string{"category": "c1"},
+			filter:  ratingFilter{max: -1},
+		},
+		{
+			name:    "rating range sorted",
+			filters: map[string]string{filterMinRating: "3.5", filterMaxRating: "4", filterSort: sortRatingDesc},
+			filter:  ratingFilter{min: 3.5, max: 4, sort: sortRatingDesc},
+			ok:      true,
+		},
+		{
+			name:    "sort only",
+			filters: map[string]string{filterSort: sortRatingAsc},
+			filter:  ratingFilter{max: -1, sort: sortRatingAsc},
+			ok:      true,
+		},
+		{
+			name:    "rating which isn't a number",
+			filters: map[string]string{filterMinRating: "high"},
+			failed:  true,
+		},
+		{
+			name:    "unknown sort",
+			filters: map[string]string{filterSort: "name"},
+			failed:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter, ok, err := parseRatingFilter(tt.filters)
+			if tt.failed {
+				if err == nil {
+					t.Fatalf("got filter %+v, want an error", filter)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if ok != tt.ok || filter != tt.filter {
+				t.Fatalf("got filter %+v (%t), want %+v (%t)", filter, ok, tt.filter, tt.ok)
+			}
+		})
+	}
+}
+
+func TestListBookByRating(t *testing.T) {
+	books := []*pb.Book{{BookId: "b1"}, {BookId: "b2"}, {BookId: "b3"}, {BookId: "b4"}, {BookId: "b5"}}
+	ratings := map[string]float32{"b1": 3, "b2": 5, "b3": 4, "b4": 3}
+
+	tests := []struct {
+		name    string
+		filters map[string]string
+		page    int64
+		limit   int64
+		ids     []string
+		count   int64
+	}{
+		{
+			name:    "rating range",
+			filters: map[string]string{filterMinRating: "3.5", filterMaxRating: "5"},
+			page:    1,
+			limit:   10,
+			ids:     []string{"b2", "b3"},
+			count:   2,
+		},
+		{
+			name:    "highest rated first, ties kept in order",
+			filters: map[string]string{filterSort: sortRatingDesc},
+			page:    1,
+			limit:   10,
+			ids:     []string{"b2", "b3", "b1", "b4", "b5"},
+			count:   5,
+		},
+		{
+			name:    "second page of the lowest rated",
+			filters: map[string]string{filterSort: sortRatingAsc, filterMinRating: "1"},
+			page:    2,
+			limit:   2,
+			ids:     []string{"b3", "b2"},
+			count:   4,
+		},
+		{
+			name:    "page past the end",
+			filters: map[string]string{filterSort: sortRatingAsc},
+			page:    4,
+			limit:   2,
+			count:   5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newRatingsTestService(books, &fakeReviewService{ratings: ratings})
+
+			resp, err := s.ListBook(context.Background(), &pb.ListBookReq{Page: tt.page, Limit: tt.limit, Filters: tt.filters})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var ids []string
+			for _, book := range resp.Books {
+				ids = append(ids, book.BookId)
+			}
+			if !reflect.DeepEqual(ids, tt.ids) || resp.Count != tt.count {
+				t.Fatalf("got books %v of %d, want %v of %d", ids, resp.Count, tt.ids, tt.count)
+			}
+		})
+	}
+}
+
+func TestListBookByRatingFails(t *testing.T) {
+	tooMany := make([]*pb.Book, maxRatingCandidates+1)
+	for i := range tooMany {
+		tooMany[i] = &pb.Book{BookId: fmt.Sprintf("b%d", i)}
+	}
+
+	tests := []struct {
+		name    string
+		books   []*pb.Book
+		reviews *fakeReviewService
+		code    codes.Code
+	}{
+		{
+			name:    "more books than are filtered by rating",
+			books:   tooMany,
+			reviews: &fakeReviewService{},
+			code:    codes.FailedPrecondition,
+		},
+		{
+			name:    "review_service down",
+			books:   tooMany[:2],
+			reviews: &fakeReviewService{err: status.Error(codes.Unavailable, "connection refused")},
+			code:    codes.Unavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newRatingsTestService(tt.books, tt.reviews)
+
+			_, err := s.ListBook(context.Background(), &pb.ListBookReq{Page: 1, Limit: 10, Filters: map[string]string{filterSort: sortRatingDesc}})
+			if code := status.Code(err); code != tt.code {
+				t.Fatalf("got code %s (%v), want %s", code, err, tt.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofrs/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	l "github.com/abdullohsattorov/catalog-service/pkg/logger"
+	"github.com/abdullohsattorov/catalog-service/storage/repo"
+)
+
+// upsertID validates the id of an upserted entity
+func upsertID(entity, id string) (string, error) {
+	if id == "" {
+		return "", status.Errorf(codes.InvalidArgument, "%s id is required", entity)
+	}
+
+	parsed, err := uuid.FromString(id)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid %s id %q, expected a uuid", entity, id)
+	}
+
+	return parsed.String(), nil
+}
+
+// upsertVersion returns the version an upsert is based on, taken from the
+// if-match metadata when the message carries none. It stays 0 for upserts
+// meant to create the entity.
+func upsertVersion(ctx context.Context, version int64) (int64, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if version == 0 && len(md.Get(ifMatchHeader)) == 0 {
+		return 0, nil
+	}
+
+	return expectedVersion(ctx, version)
+}
+
+func (s *CatalogService) UpsertBook(ctx context.Context, req *pb.Book) (*pb.Book, error) {
+	id, err := upsertID("book", req.BookId)
+	if err != nil {
+		return nil, err
+	}
+	req.BookId = id
+
+	if err = normalizeIsbn(req); err != nil {
+		return nil, err
+	}
+
+	if req.Version, err = upsertVersion(ctx, req.Version); err != nil {
+		return nil, err
+	}
+
+	book, _, err := s.storage.Book().UpsertBook(ctx, *req)
+	if errors.Is(err, repo.ErrStaleVersion) {
+		return nil, staleVersionError("book")
+	}
+	if errors.Is(err, repo.ErrVersionRequired) {
+		return nil, errVersionRequired
+	}
+	if errors.Is(err, repo.ErrAlreadyExists) {
+		return nil, status.Error(codes.AlreadyExists, "another book has this isbn or external id")
+	}
+	if err != nil {
+		s.log(ctx).Error("failed to upsert book", l.Error(err))
+		return nil, status.Error(codes.Internal, "failed to upsert book")
+	}
+
+	setETag(ctx, book.Version)
+
+	return &book, nil
+}
+
+func (s *CatalogService) UpsertAuthor(ctx context.Context, req *pb.Author) (*pb.Author, error) {
+	id, err := upsertID("author", req.AuthorId)
+	if err != nil {
+		return nil, err
+	}
+	req.AuthorId = id
+
+	if req.Version, err = upsertVersion(ctx, req.Version); err != nil {
+		return nil, err
+	}
+
+	author, _, err := s.storage.Author().UpsertAuthor(ctx, *req)
+	if errors.Is(err, repo.ErrStaleVersion) {
+		return nil, staleVersionError("author")
+	}
+	if errors.Is(err, repo.ErrVersionRequired) {
+		return nil, errVersionRequired
+	}
+	if err != nil {
+		s.log(ctx).Error("failed to upsert author", l.Error(err))
+		return nil, status.Error(codes.Internal, "failed to upsert author")
+	}
+
+	setETag(ctx, author.Version)
+
+	return &author, nil
+}
+
+func (s *CatalogService) UpsertCategory(ctx context.Context, req *pb.Category) (*pb.Category, error) {
+	id, err := upsertID("category", req.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+	req.CategoryId = id
+
+	if req.ParentUuid == req.CategoryId {
+		return nil, status.Error(codes.InvalidArgument, "category can't be its own parent")
+	}
+
+	if req.Version, err = upsertVersion(ctx, req.Version); err != nil {
+		return nil, err
+	}
+
+	category, _, err := s.storage.Category().UpsertCategory(ctx, *req)
+	if errors.Is(err, repo.ErrStaleVersion) {
+		return nil, staleVersionError("category")
+	}
+	if errors.Is(err, repo.ErrVersionRequired) {
+		return nil, errVersionRequired
+	}
+	if err != nil {
+		s.log(ctx).Error("failed to upsert category", l.Error(err))
+		return nil, status.Error(codes.Internal, "failed to upsert category")
+	}
+
+	setETag(ctx, category.Version)
+
+	return &category, nil
+}
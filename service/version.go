@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Version metadata mirroring HTTP ETag and If-Match headers
+const (
+	etagHeader    = "etag"
+	ifMatchHeader = "if-match"
+)
+
+var errVersionRequired = status.Error(codes.InvalidArgument,
+	"version is required, send the version the update is based on or an if-match header")
+
+// expectedVersion returns the version an update is based on. When the message
+// carries no version it is taken from the if-match metadata.
+func expectedVersion(ctx context.Context, version int64) (int64, error) {
+	if version != 0 {
+		return version, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(ifMatchHeader)
+	if len(values) == 0 {
+		return 0, errVersionRequired
+	}
+
+	version, err := strconv.ParseInt(strings.Trim(values[0], `"`), 10, 64)
+	if err != nil || version <= 0 {
+		return 0, errVersionRequired
+	}
+
+	return version, nil
+}
+
+// setETag sends the version of the returned entity as etag header metadata
+func setETag(ctx context.Context, version int64) {
+	_ = grpc.SetHeader(ctx, metadata.Pairs(etagHeader, strconv.Quote(strconv.FormatInt(version, 10))))
+}
+
+func staleVersionError(entity string) error {
+	return status.Errorf(codes.Aborted, "%s was changed since the given version, get it again and retry", entity)
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	"github.com/abdullohsattorov/catalog-service/pkg/events"
+	l "github.com/abdullohsattorov/catalog-service/pkg/logger"
+)
+
+const (
+	watchBatchSize = 100
+	// watchPollInterval is how often the change log is read when no
+	// notification arrives, in case one was lost
+	watchPollInterval = 5 * time.Second
+)
+
+func (s *CatalogService) WatchCatalog(req *pb.WatchCatalogReq, stream pb.CatalogService_WatchCatalogServer) error {
+	for _, entityType := range req.EntityTypes {
+		switch entityType {
+		case events.AggregateBook, events.AggregateAuthor, events.AggregateCategory:
+		default:
+			return status.Errorf(codes.InvalidArgument, "unknown entity type %q", entityType)
+		}
+	}
+
+	if req.FromBeginning && req.FromSequence != 0 {
+		return status.Error(codes.InvalidArgument, "FromBeginning and FromSequence can't be set together")
+	}
+
+	ctx := stream.Context()
+
+	wake, unsubscribe := s.hub.Subscribe()
+	defer unsubscribe()
+
+	purgedThrough, last, err := s.storage.Outbox().SequenceRange(ctx)
+	if err != nil {
+		s.log(ctx).Error("failed to get catalog event sequences", l.Error(err))
+		return status.Error(codes.Internal, "failed to watch catalog")
+	}
+
+	after := req.FromSequence
+	switch {
+	case req.FromBeginning:
+		after = purgedThrough
+	case after == 0:
+		after = last
+	case after < purgedThrough:
+		return status.Errorf(codes.OutOfRange, "events up to sequence %d were purged, watch from the beginning", purgedThrough)
+	}
+
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		for {
+			list, err := s.storage.Outbox().ListEvents(ctx, after, req.EntityTypes, watchBatchSize)
+			if err != nil {
+				s.log(ctx).Error("failed to list catalog events", l.Error(err))
+				return status.Error(codes.Internal, "failed to watch catalog")
+			}
+
+			for _, event := range list {
+				err = stream.Send(&pb.CatalogEvent{
+					Sequence:   event.Sequence,
+					EntityType: event.AggregateType,
+					EntityId:   event.AggregateID,
+					EventType:  event.EventType,
+					Payload:    string(event.Payload),
+					CreatedAt:  event.CreatedAt,
+				})
+				if err != nil {
+					return err
+				}
+				after = event.Sequence
+			}
+
+			if len(list) < watchBatchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.hub.Done():
+			return status.Error(codes.Unavailable, "server is shutting down")
+		case <-wake:
+		case <-ticker.C:
+		}
+	}
+}
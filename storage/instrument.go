@@ -0,0 +1,393 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	"github.com/abdullohsattorov/catalog-service/pkg/events"
+	"github.com/abdullohsattorov/catalog-service/pkg/metrics"
+	"github.com/abdullohsattorov/catalog-service/storage/repo"
+)
+
+// Repository names as they are labelled in query metrics and spans
+const (
+	repoBook        = "book"
+	repoAuthor      = "author"
+	repoCategory    = "category"
+	repoOutbox      = "outbox"
+	repoAudit       = "audit"
+	repoIdempotency = "idempotency"
+)
+
+var tracer = otel.Tracer("github.com/abdullohsattorov/catalog-service/storage")
+
+// instrument traces the methods of a repository and records how long they
+// take
+type instrument struct {
+	repository string
+	m          *metrics.Metrics
+}
+
+// start starts the span of a repository method. The returned function ends
+// it, see end. Batch methods pass nil since their items fail on their own.
+func (in instrument) start(ctx context.Context, method string) (context.Context, func(err *error)) {
+	began := time.Now()
+	ctx, span := tracer.Start(ctx, in.repository+"."+method, trace.WithAttributes(
+		attribute.String("repository", in.repository),
+		attribute.String("method", method),
+	))
+
+	return ctx, func(err *error) {
+		in.end(span, method, began, err)
+	}
+}
+
+// startPoll is start for the methods background pollers call every second,
+// which mostly find nothing to do. Outside of a traced request such a call
+// gets a span and is timed only when it did work or failed, so idle polls
+// neither start a trace each nor skew the query durations. The span is
+// recorded once the call is over, without the statements it ran.
+func (in instrument) startPoll(ctx context.Context, method string) (context.Context, func(n int64, err *error)) {
+	if trace.SpanContextFromContext(ctx).IsValid() {
+		ctx, end := in.start(ctx, method)
+		return ctx, func(n int64, err *error) {
+			end(err)
+		}
+	}
+
+	began := time.Now()
+	return ctx, func(n int64, err *error) {
+		if n == 0 && (err == nil || *err == nil) {
+			return
+		}
+
+		_, span := tracer.Start(ctx, in.repository+"."+method, trace.WithTimestamp(began), trace.WithAttributes(
+			attribute.String("repository", in.repository),
+			attribute.String("method", method),
+			attribute.Int64("count", n),
+		))
+		in.end(span, method, began, err)
+	}
+}
+
+// end ends the span of a method started at began, marking it failed if err
+// points to an error other than a missing row
+func (in instrument) end(span trace.Span, method string, began time.Time, err *error) {
+	if err != nil && *err != nil && !errors.Is(*err, sql.ErrNoRows) {
+		span.RecordError(*err)
+		span.SetStatus(codes.Error, (*err).Error())
+	}
+	span.End()
+	in.m.ObserveQuery(in.repository, method, began)
+}
+
+type storageInstrumented struct {
+	book        repo.BookStorageI
+	author      repo.AuthorStorageI
+	category    repo.CategoryStorageI
+	outbox      repo.OutboxStorageI
+	audit       repo.AuditStorageI
+	idempotency repo.IdempotencyStorageI
+}
+
+// Instrument wraps every repository of storage to trace its methods and
+// record how long they take
+func Instrument(storage IStorage, m *metrics.Metrics) IStorage {
+	return storageInstrumented{
+		book:        bookInstrumented{storage.Book(), instrument{repoBook, m}},
+		author:      authorInstrumented{storage.Author(), instrument{repoAuthor, m}},
+		category:    categoryInstrumented{storage.Category(), instrument{repoCategory, m}},
+		outbox:      outboxInstrumented{storage.Outbox(), instrument{repoOutbox, m}},
+		audit:       auditInstrumented{storage.Audit(), instrument{repoAudit, m}},
+		idempotency: idempotencyInstrumented{storage.Idempotency(), instrument{repoIdempotency, m}},
+	}
+}
+
+func (s storageInstrumented) Book() repo.BookStorageI {
+	return s.book
+}
+
+func (s storageInstrumented) Author() repo.AuthorStorageI {
+	return s.author
+}
+
+func (s storageInstrumented) Category() repo.CategoryStorageI {
+	return s.category
+}
+
+func (s storageInstrumented) Outbox() repo.OutboxStorageI {
+	return s.outbox
+}
+
+func (s storageInstrumented) Audit() repo.AuditStorageI {
+	return s.audit
+}
+
+func (s storageInstrumented) Idempotency() repo.IdempotencyStorageI {
+	return s.idempotency
+}
+
+type bookInstrumented struct {
+	next repo.BookStorageI
+	instrument
+}
+
+func (r bookInstrumented) CreateBook(ctx context.Context, book pb.Book) (_ pb.Book, err error) {
+	ctx, end := r.start(ctx, "CreateBook")
+	defer end(&err)
+	return r.next.CreateBook(ctx, book)
+}
+
+func (r bookInstrumented) GetBook(ctx context.Context, id string) (_ pb.Book, err error) {
+	ctx, end := r.start(ctx, "GetBook")
+	defer end(&err)
+	return r.next.GetBook(ctx, id)
+}
+
+func (r bookInstrumented) ListBook(ctx context.Context, page, limit int64, filters map[string]string) (_ []*pb.Book, _ int64, err error) {
+	ctx, end := r.start(ctx, "ListBook")
+	defer end(&err)
+	return r.next.ListBook(ctx, page, limit, filters)
+}
+
+func (r bookInstrumented) UpdateBook(ctx context.Context, update pb.UpdateBookReq) (_ pb.Book, err error) {
+	ctx, end := r.start(ctx, "UpdateBook")
+	defer end(&err)
+	return r.next.UpdateBook(ctx, update)
+}
+
+func (r bookInstrumented) DeleteBook(ctx context.Context, id string) (err error) {
+	ctx, end := r.start(ctx, "DeleteBook")
+	defer end(&err)
+	return r.next.DeleteBook(ctx, id)
+}
+
+func (r bookInstrumented) GetBookVersion(ctx context.Context, id string, version int64) (_ pb.Book, err error) {
+	ctx, end := r.start(ctx, "GetBookVersion")
+	defer end(&err)
+	return r.next.GetBookVersion(ctx, id, version)
+}
+
+func (r bookInstrumented) GetBookAsOf(ctx context.Context, id string, asOf time.Time) (_ pb.Book, err error) {
+	ctx, end := r.start(ctx, "GetBookAsOf")
+	defer end(&err)
+	return r.next.GetBookAsOf(ctx, id, asOf)
+}
+
+func (r bookInstrumented) BatchGetBooks(ctx context.Context, ids []string) (_ []*pb.Book, err error) {
+	ctx, end := r.start(ctx, "BatchGetBooks")
+	defer end(&err)
+	return r.next.BatchGetBooks(ctx, ids)
+}
+
+func (r bookInstrumented) BatchCreateBooks(ctx context.Context, books []pb.Book, allOrNothing bool) ([]pb.Book, []error) {
+	ctx, end := r.start(ctx, "BatchCreateBooks")
+	defer end(nil)
+	return r.next.BatchCreateBooks(ctx, books, allOrNothing)
+}
+
+func (r bookInstrumented) BatchUpdateBooks(ctx context.Context, updates []pb.UpdateBookReq, allOrNothing bool) ([]pb.Book, []error) {
+	ctx, end := r.start(ctx, "BatchUpdateBooks")
+	defer end(nil)
+	return r.next.BatchUpdateBooks(ctx, updates, allOrNothing)
+}
+
+func (r bookInstrumented) FindBooksByIdentifiers(ctx context.Context, isbn, externalID string) (_ []*pb.Book, err error) {
+	ctx, end := r.start(ctx, "FindBooksByIdentifiers")
+	defer end(&err)
+	return r.next.FindBooksByIdentifiers(ctx, isbn, externalID)
+}
+
+func (r bookInstrumented) ScanBooks(ctx context.Context, afterID string, limit int) (_ []*pb.Book, err error) {
+	ctx, end := r.start(ctx, "ScanBooks")
+	defer end(&err)
+	return r.next.ScanBooks(ctx, afterID, limit)
+}
+
+func (r bookInstrumented) UpsertBook(ctx context.Context, book pb.Book) (_ pb.Book, _ bool, err error) {
+	ctx, end := r.start(ctx, "UpsertBook")
+	defer end(&err)
+	return r.next.UpsertBook(ctx, book)
+}
+
+type authorInstrumented struct {
+	next repo.AuthorStorageI
+	instrument
+}
+
+func (r authorInstrumented) CreateAuthor(ctx context.Context, author pb.Author) (_ pb.Author, err error) {
+	ctx, end := r.start(ctx, "CreateAuthor")
+	defer end(&err)
+	return r.next.CreateAuthor(ctx, author)
+}
+
+func (r authorInstrumented) GetAuthor(ctx context.Context, id string) (_ pb.Author, err error) {
+	ctx, end := r.start(ctx, "GetAuthor")
+	defer end(&err)
+	return r.next.GetAuthor(ctx, id)
+}
+
+func (r authorInstrumented) ListAuthor(ctx context.Context, page, limit int64) (_ []*pb.Author, _ int64, err error) {
+	ctx, end := r.start(ctx, "ListAuthor")
+	defer end(&err)
+	return r.next.ListAuthor(ctx, page, limit)
+}
+
+func (r authorInstrumented) UpdateAuthor(ctx context.Context, update pb.UpdateAuthorReq) (_ pb.Author, err error) {
+	ctx, end := r.start(ctx, "UpdateAuthor")
+	defer end(&err)
+	return r.next.UpdateAuthor(ctx, update)
+}
+
+func (r authorInstrumented) DeleteAuthor(ctx context.Context, id string) (err error) {
+	ctx, end := r.start(ctx, "DeleteAuthor")
+	defer end(&err)
+	return r.next.DeleteAuthor(ctx, id)
+}
+
+func (r authorInstrumented) BatchGetAuthors(ctx context.Context, ids []string) (_ []*pb.Author, err error) {
+	ctx, end := r.start(ctx, "BatchGetAuthors")
+	defer end(&err)
+	return r.next.BatchGetAuthors(ctx, ids)
+}
+
+func (r authorInstrumented) UpsertAuthor(ctx context.Context, author pb.Author) (_ pb.Author, _ bool, err error) {
+	ctx, end := r.start(ctx, "UpsertAuthor")
+	defer end(&err)
+	return r.next.UpsertAuthor(ctx, author)
+}
+
+type categoryInstrumented struct {
+	next repo.CategoryStorageI
+	instrument
+}
+
+func (r categoryInstrumented) CreateCategory(ctx context.Context, category pb.Category) (_ pb.Category, err error) {
+	ctx, end := r.start(ctx, "CreateCategory")
+	defer end(&err)
+	return r.next.CreateCategory(ctx, category)
+}
+
+func (r categoryInstrumented) GetCategory(ctx context.Context, id string) (_ pb.Category, err error) {
+	ctx, end := r.start(ctx, "GetCategory")
+	defer end(&err)
+	return r.next.GetCategory(ctx, id)
+}
+
+func (r categoryInstrumented) ListCategory(ctx context.Context, page, limit int64) (_ []*pb.Category, _ int64, err error) {
+	ctx, end := r.start(ctx, "ListCategory")
+	defer end(&err)
+	return r.next.ListCategory(ctx, page, limit)
+}
+
+func (r categoryInstrumented) UpdateCategory(ctx context.Context, update pb.UpdateCategoryReq) (_ pb.Category, err error) {
+	ctx, end := r.start(ctx, "UpdateCategory")
+	defer end(&err)
+	return r.next.UpdateCategory(ctx, update)
+}
+
+func (r categoryInstrumented) DeleteCategory(ctx context.Context, id string) (err error) {
+	ctx, end := r.start(ctx, "DeleteCategory")
+	defer end(&err)
+	return r.next.DeleteCategory(ctx, id)
+}
+
+func (r categoryInstrumented) BatchGetCategories(ctx context.Context, ids []string) (_ []*pb.Category, err error) {
+	ctx, end := r.start(ctx, "BatchGetCategories")
+	defer end(&err)
+	return r.next.BatchGetCategories(ctx, ids)
+}
+
+func (r categoryInstrumented) UpsertCategory(ctx context.Context, category pb.Category) (_ pb.Category, _ bool, err error) {
+	ctx, end := r.start(ctx, "UpsertCategory")
+	defer end(&err)
+	return r.next.UpsertCategory(ctx, category)
+}
+
+type outboxInstrumented struct {
+	next repo.OutboxStorageI
+	instrument
+}
+
+// PublishPending includes publishing, which happens inside its transaction.
+// The relay polls it, see startPoll.
+func (r outboxInstrumented) PublishPending(
+	ctx context.Context, limit int, lease time.Duration, publish func(ctx context.Context, events []events.Event) error,
+) (n int, err error) {
+	ctx, end := r.startPoll(ctx, "PublishPending")
+	defer func() { end(int64(n), &err) }()
+	return r.next.PublishPending(ctx, limit, lease, publish)
+}
+
+func (r outboxInstrumented) PurgePublished(ctx context.Context, before time.Time) (_ int64, err error) {
+	ctx, end := r.start(ctx, "PurgePublished")
+	defer end(&err)
+	return r.next.PurgePublished(ctx, before)
+}
+
+// AssignSequences is polled by the hub, see startPoll
+func (r outboxInstrumented) AssignSequences(ctx context.Context) (n int64, err error) {
+	ctx, end := r.startPoll(ctx, "AssignSequences")
+	defer func() { end(n, &err) }()
+	return r.next.AssignSequences(ctx)
+}
+
+func (r outboxInstrumented) ListEvents(ctx context.Context, afterSequence int64, aggregateTypes []string, limit int) (_ []events.Event, err error) {
+	ctx, end := r.start(ctx, "ListEvents")
+	defer end(&err)
+	return r.next.ListEvents(ctx, afterSequence, aggregateTypes, limit)
+}
+
+func (r outboxInstrumented) SequenceRange(ctx context.Context) (_, _ int64, err error) {
+	ctx, end := r.start(ctx, "SequenceRange")
+	defer end(&err)
+	return r.next.SequenceRange(ctx)
+}
+
+type auditInstrumented struct {
+	next repo.AuditStorageI
+	instrument
+}
+
+func (r auditInstrumented) ListAuditEvents(ctx context.Context, req pb.ListAuditEventsReq) (_ []*pb.AuditEvent, _ int64, err error) {
+	ctx, end := r.start(ctx, "ListAuditEvents")
+	defer end(&err)
+	return r.next.ListAuditEvents(ctx, req)
+}
+
+type idempotencyInstrumented struct {
+	next repo.IdempotencyStorageI
+	instrument
+}
+
+func (r idempotencyInstrumented) ReserveIdempotencyKey(ctx context.Context, key repo.IdempotencyKey, expiresBefore, lockedUntil time.Time) (_ repo.IdempotencyKey, _ bool, err error) {
+	ctx, end := r.start(ctx, "ReserveIdempotencyKey")
+	defer end(&err)
+	return r.next.ReserveIdempotencyKey(ctx, key, expiresBefore, lockedUntil)
+}
+
+func (r idempotencyInstrumented) CompleteIdempotencyKey(ctx context.Context, key repo.IdempotencyKey, response []byte) (err error) {
+	ctx, end := r.start(ctx, "CompleteIdempotencyKey")
+	defer end(&err)
+	return r.next.CompleteIdempotencyKey(ctx, key, response)
+}
+
+func (r idempotencyInstrumented) ReleaseIdempotencyKey(ctx context.Context, key repo.IdempotencyKey) (err error) {
+	ctx, end := r.start(ctx, "ReleaseIdempotencyKey")
+	defer end(&err)
+	return r.next.ReleaseIdempotencyKey(ctx, key)
+}
+
+func (r idempotencyInstrumented) PurgeIdempotencyKeys(ctx context.Context, before time.Time) (_ int64, err error) {
+	ctx, end := r.start(ctx, "PurgeIdempotencyKeys")
+	defer end(&err)
+	return r.next.PurgeIdempotencyKeys(ctx, before)
+}
@@ -0,0 +1,96 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/abdullohsattorov/catalog-service/pkg/metrics"
+	"github.com/abdullohsattorov/catalog-service/storage/repo"
+)
+
+// noConnector never connects, metrics only read the stats of its pool
+type noConnector struct{}
+
+func (noConnector) Connect(context.Context) (driver.Conn, error) {
+	return nil, errors.New("no database")
+}
+
+func (noConnector) Driver() driver.Driver {
+	return nil
+}
+
+// sequencer assigns n sequences on every call, or fails with err
+type sequencer struct {
+	repo.OutboxStorageI
+	n   int64
+	err error
+}
+
+func (s sequencer) AssignSequences(ctx context.Context) (int64, error) {
+	return s.n, s.err
+}
+
+func TestPollsAreInstrumentedWhenTheyDoWork(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	tests := []struct {
+		name   string
+		outbox sequencer
+		// traced calls the poller from a traced request
+		traced       bool
+		instrumented bool
+	}{
+		{name: "idle poll", outbox: sequencer{}},
+		{name: "poll which did work", outbox: sequencer{n: 3}, instrumented: true},
+		{name: "failed poll", outbox: sequencer{err: errors.New("connection refused")}, instrumented: true},
+		{name: "idle call of a traced request", outbox: sequencer{}, traced: true, instrumented: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := sql.OpenDB(noConnector{})
+			defer db.Close()
+			m := metrics.New(db, "catalog")
+			outbox := outboxInstrumented{tt.outbox, instrument{repoOutbox, m}}
+			ended := len(recorder.Ended())
+
+			ctx := context.Background()
+			if tt.traced {
+				var request trace.Span
+				ctx, request = otel.Tracer("test").Start(ctx, "request")
+				defer request.End()
+			}
+			_, _ = outbox.AssignSequences(ctx)
+
+			var spans int
+			for _, span := range recorder.Ended()[ended:] {
+				if span.Name() == "outbox.AssignSequences" {
+					spans++
+				}
+			}
+			if tt.instrumented != (spans == 1) {
+				t.Fatalf("got %d spans, want a span %t", spans, tt.instrumented)
+			}
+
+			w := httptest.NewRecorder()
+			m.Handler().ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+			body, _ := ioutil.ReadAll(w.Body)
+			observed := strings.Contains(string(body), `catalog_db_query_duration_seconds_count{method="AssignSequences",repository="outbox"} 1`)
+			if observed != tt.instrumented {
+				t.Fatalf("got the call timed %t, want %t", observed, tt.instrumented)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/jmoiron/sqlx"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	"github.com/abdullohsattorov/catalog-service/pkg/audit"
+)
+
+type auditRepo struct {
+	db *sqlx.DB
+}
+
+// NewAuditRepo ...
+func NewAuditRepo(db *sqlx.DB) *auditRepo {
+	return &auditRepo{db: db}
+}
+
+func (r *auditRepo) ListAuditEvents(ctx context.Context, req pb.ListAuditEventsReq) ([]*pb.AuditEvent, int64, error) {
+	offset := (req.Page - 1) * req.Limit
+
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select("id", "actor", "entity_type", "entity_id", "operation", "changes", "created_at")
+	sb.From("audit_events")
+	auditFilters(sb, req)
+	sb.OrderBy("id").Desc()
+	sb.Limit(int(req.Limit))
+	sb.Offset(int(offset))
+
+	query, args := sb.BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	rows, err := r.db.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var (
+		auditEvents []*pb.AuditEvent
+		count       int64
+	)
+
+	for rows.Next() {
+		var (
+			event   pb.AuditEvent
+			changes []byte
+		)
+		err = rows.Scan(&event.Id, &event.Actor, &event.EntityType, &event.EntityId, &event.Operation, &changes, &event.CreatedAt)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if err = json.Unmarshal(changes, &event.Changes); err != nil {
+			return nil, 0, err
+		}
+
+		auditEvents = append(auditEvents, &event)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	sbc := sqlbuilder.NewSelectBuilder()
+	sbc.Select("count(*)")
+	sbc.From("audit_events")
+	auditFilters(sbc, req)
+
+	query, args = sbc.BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	err = r.db.QueryRowContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return auditEvents, count, nil
+}
+
+func auditFilters(sb *sqlbuilder.SelectBuilder, req pb.ListAuditEventsReq) {
+	if req.EntityType != "" {
+		sb.Where(sb.Equal("entity_type", req.EntityType))
+	}
+	if req.EntityId != "" {
+		sb.Where(sb.Equal("entity_id", req.EntityId))
+	}
+	if req.From != "" {
+		sb.Where(sb.GreaterEqualThan("created_at", req.From))
+	}
+	if req.To != "" {
+		sb.Where(sb.LessThan("created_at", req.To))
+	}
+}
+
+// writeAudit records the mutation of the entity as made by the actor of ctx,
+// it has to run in the transaction making the change so a change is never
+// committed without its audit event. before is nil for creates and after is
+// nil for deletes.
+func writeAudit(ctx context.Context, tx *sqlx.Tx, entityType, entityID, operation string, before, after interface{}) error {
+	changes, err := json.Marshal(audit.Diff(before, after))
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO audit_events(actor, entity_type, entity_id, operation, changes, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		audit.Actor(ctx), entityType, entityID, operation, changes, time.Now().UTC())
+
+	return err
+}
@@ -1,12 +1,14 @@
 package postgres
 
 import (
-	"database/sql"
+	"context"
 	"time"
 
 	"github.com/jmoiron/sqlx"
 
 	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	"github.com/abdullohsattorov/catalog-service/pkg/audit"
+	"github.com/abdullohsattorov/catalog-service/pkg/events"
 )
 
 type authorRepo struct {
@@ -18,33 +20,49 @@ func NewAuthorRepo(db *sqlx.DB) *authorRepo {
 	return &authorRepo{db: db}
 }
 
-func (r *authorRepo) CreateAuthor(author pb.Author) (pb.Author, error) {
-	var id string
+func (r *authorRepo) CreateAuthor(ctx context.Context, author pb.Author) (pb.Author, error) {
+	var NewAuthor pb.Author
 
-	err := r.db.QueryRow(`
+	err := withTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		var id string
+		err := tx.QueryRowContext(ctx, `
 				   INSERT INTO authors (author_id, name, created_at, updated_at) 
 				   VALUES ($1, $2, $3, $4) RETURNING author_id `,
-		author.AuthorId, author.Name, time.Now().UTC(), time.Now().UTC()).
-		Scan(&id)
-	if err != nil {
-		return pb.Author{}, err
-	}
+			author.AuthorId, author.Name, time.Now().UTC(), time.Now().UTC()).
+			Scan(&id)
+		if err != nil {
+			return err
+		}
 
-	NewAuthor, err := r.GetAuthor(id)
+		NewAuthor, err = getAuthor(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		if err = writeEvent(ctx, tx, events.AggregateAuthor, id, events.TypeCreated, NewAuthor); err != nil {
+			return err
+		}
+
+		return writeAudit(ctx, tx, events.AggregateAuthor, id, audit.OpCreate, nil, &NewAuthor)
+	})
 	if err != nil {
-		return pb.Author{}, err
+		return pb.Author{}, alreadyExists(err)
 	}
 
 	return NewAuthor, nil
 }
 
-func (r *authorRepo) GetAuthor(id string) (pb.Author, error) {
+func (r *authorRepo) GetAuthor(ctx context.Context, id string) (pb.Author, error) {
+	return getAuthor(ctx, r.db, id)
+}
+
+func getAuthor(ctx context.Context, q sqlx.QueryerContext, id string) (pb.Author, error) {
 	var NewAuthor pb.Author
 
-	err := r.db.QueryRow(`
-						SELECT author_id, name, created_at, updated_at FROM authors 
+	err := q.QueryRowxContext(ctx, `
+						SELECT author_id, name, created_at, updated_at, version FROM authors 
 						WHERE author_id = $1 AND deleted_at IS NULL`, id).
-		Scan(&NewAuthor.AuthorId, &NewAuthor.Name, &NewAuthor.CreatedAt, &NewAuthor.UpdatedAt)
+		Scan(&NewAuthor.AuthorId, &NewAuthor.Name, &NewAuthor.CreatedAt, &NewAuthor.UpdatedAt, &NewAuthor.Version)
 	if err != nil {
 		return pb.Author{}, err
 	}
@@ -52,11 +70,11 @@ func (r *authorRepo) GetAuthor(id string) (pb.Author, error) {
 	return NewAuthor, nil
 }
 
-func (r *authorRepo) ListAuthor(page, limit int64) ([]*pb.Author, int64, error) {
+func (r *authorRepo) ListAuthor(ctx context.Context, page, limit int64) ([]*pb.Author, int64, error) {
 	offset := (page - 1) * limit
 
-	rows, err := r.db.Queryx(`
-				SELECT author_id, name, created_at, updated_at FROM authors 
+	rows, err := r.db.QueryxContext(ctx, `
+				SELECT author_id, name, created_at, updated_at, version FROM authors 
 				WHERE deleted_at is NULL ORDER BY author_id LIMIT $1 OFFSET $2
 				`, limit, offset)
 	if err != nil {
@@ -70,50 +88,85 @@ func (r *authorRepo) ListAuthor(page, limit int64) ([]*pb.Author, int64, error)
 
 	for rows.Next() {
 		var author pb.Author
-		err = rows.Scan(&author.AuthorId, &author.Name, &author.CreatedAt, &author.UpdatedAt)
+		err = rows.Scan(&author.AuthorId, &author.Name, &author.CreatedAt, &author.UpdatedAt, &author.Version)
 		if err != nil {
 			return nil, 0, err
 		}
 		authors = append(authors, &author)
 	}
 
-	err = r.db.QueryRow("SELECT count(*) FROM authors WHERE deleted_at IS NULL").Scan(&count)
+	err = r.db.QueryRowContext(ctx, "SELECT count(*) FROM authors WHERE deleted_at IS NULL").Scan(&count)
 	if err != nil {
 		return nil, 0, err
 	}
 	return authors, count, nil
 }
 
-func (r *authorRepo) UpdateAuthor(update pb.Author) (pb.Author, error) {
-	result, err := r.db.Exec("UPDATE authors SET name = $2, updated_at = $3 WHERE author_id = $1",
-		update.AuthorId, update.Name, time.Now().UTC())
-	if err != nil {
-		return pb.Author{}, err
-	}
+func (r *authorRepo) UpdateAuthor(ctx context.Context, update pb.UpdateAuthorReq) (pb.Author, error) {
+	var (
+		NewAuthor pb.Author
+		author    = update.Author
+	)
 
-	if i, _ := result.RowsAffected(); i == 0 {
-		return pb.Author{}, sql.ErrNoRows
-	}
+	err := withTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		if err := lockRow(ctx, tx, "authors", "author_id", author.AuthorId); err != nil {
+			return err
+		}
 
-	var NewAuthor pb.Author
+		before, err := getAuthor(ctx, tx, author.AuthorId)
+		if err != nil {
+			return err
+		}
+
+		result, err := tx.ExecContext(ctx, `UPDATE authors SET name = $2, updated_at = $3, version = version + 1
+			WHERE author_id = $1 AND version = $4 AND deleted_at IS NULL`,
+			author.AuthorId, author.Name, time.Now().UTC(), author.Version)
+		if err != nil {
+			return err
+		}
 
-	NewAuthor, err = r.GetAuthor(update.AuthorId)
+		if i, _ := result.RowsAffected(); i == 0 {
+			return staleOrMissing(ctx, tx, "authors", "author_id", author.AuthorId)
+		}
 
+		NewAuthor, err = getAuthor(ctx, tx, author.AuthorId)
+		if err != nil {
+			return err
+		}
+
+		if err = writeEvent(ctx, tx, events.AggregateAuthor, author.AuthorId, events.TypeUpdated, NewAuthor); err != nil {
+			return err
+		}
+
+		return writeAudit(ctx, tx, events.AggregateAuthor, author.AuthorId, audit.OpUpdate, &before, &NewAuthor)
+	})
 	if err != nil {
 		return pb.Author{}, err
 	}
+
 	return NewAuthor, nil
 }
 
-func (r *authorRepo) DeleteAuthor(id string) error {
-	result, err := r.db.Exec("UPDATE authors SET deleted_at = $2 WHERE author_id = $1", id, time.Now().UTC())
-	if err != nil {
-		return err
-	}
+func (r *authorRepo) DeleteAuthor(ctx context.Context, id string) error {
+	return withTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		if err := lockRow(ctx, tx, "authors", "author_id", id); err != nil {
+			return err
+		}
 
-	if i, _ := result.RowsAffected(); i == 0 {
-		return sql.ErrNoRows
-	}
+		before, err := getAuthor(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, "UPDATE authors SET deleted_at = $2, version = version + 1 WHERE author_id = $1", id, time.Now().UTC())
+		if err != nil {
+			return err
+		}
+
+		if err = writeEvent(ctx, tx, events.AggregateAuthor, id, events.TypeDeleted, pb.ByIdReq{Id: id}); err != nil {
+			return err
+		}
 
-	return nil
+		return writeAudit(ctx, tx, events.AggregateAuthor, id, audit.OpDelete, &before, nil)
+	})
 }
@@ -0,0 +1,262 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	"github.com/abdullohsattorov/catalog-service/storage/repo"
+)
+
+// runBatch calls fn for every item of a batch and returns the error of each
+// of them. Items run in their own transactions unless allOrNothing is set,
+// then they share one which is rolled back on the first failure and every
+// other item gets repo.ErrBatchAborted.
+func runBatch(ctx context.Context, db *sqlx.DB, n int, allOrNothing bool, fn func(tx *sqlx.Tx, i int) error) []error {
+	errs := make([]error, n)
+
+	if !allOrNothing {
+		for i := range errs {
+			errs[i] = withTx(ctx, db, func(tx *sqlx.Tx) error {
+				return fn(tx, i)
+			})
+		}
+		return errs
+	}
+
+	failed := -1
+	err := withTx(ctx, db, func(tx *sqlx.Tx) error {
+		for i := 0; i < n; i++ {
+			if err := fn(tx, i); err != nil {
+				failed = i
+				return err
+			}
+		}
+		return nil
+	})
+	if err == nil {
+		return errs
+	}
+
+	// failed stays -1 when the commit itself fails
+	for i := range errs {
+		if i == failed || failed < 0 {
+			errs[i] = err
+		} else {
+			errs[i] = repo.ErrBatchAborted
+		}
+	}
+
+	return errs
+}
+
+func (r *bookRepo) BatchCreateBooks(ctx context.Context, books []pb.Book, allOrNothing bool) ([]pb.Book, []error) {
+	created := make([]pb.Book, len(books))
+
+	errs := runBatch(ctx, r.db, len(books), allOrNothing, func(tx *sqlx.Tx, i int) (err error) {
+		created[i], err = createBook(ctx, tx, books[i])
+		return err
+	})
+	for i, err := range errs {
+		if err != nil {
+			created[i] = pb.Book{}
+			errs[i] = alreadyExists(err)
+		}
+	}
+
+	return created, errs
+}
+
+func (r *bookRepo) BatchUpdateBooks(ctx context.Context, updates []pb.UpdateBookReq, allOrNothing bool) ([]pb.Book, []error) {
+	updated := make([]pb.Book, len(updates))
+
+	errs := runBatch(ctx, r.db, len(updates), allOrNothing, func(tx *sqlx.Tx, i int) (err error) {
+		updated[i], err = updateBook(ctx, tx, updates[i])
+		return err
+	})
+	for i, err := range errs {
+		if err != nil {
+			updated[i] = pb.Book{}
+			errs[i] = alreadyExists(err)
+		}
+	}
+
+	return updated, errs
+}
+
+// BatchGetBooks returns the existing books among ids in no particular order
+func (r *bookRepo) BatchGetBooks(ctx context.Context, ids []string) ([]*pb.Book, error) {
+	rows, err := r.db.QueryxContext(ctx, `
+        SELECT book_id, name, author_id, price, coalesce(isbn, ''), coalesce(external_id, ''), created_at, updated_at, version FROM books
+        WHERE book_id = any($1) and deleted_at is null`, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var (
+		books []*pb.Book
+		byID  = map[string]*pb.Book{}
+	)
+	for rows.Next() {
+		var book pb.Book
+		err = rows.Scan(&book.BookId, &book.Name, &book.AuthorId, &book.Price, &book.Isbn, &book.ExternalId, &book.CreatedAt, &book.UpdatedAt, &book.Version)
+		if err != nil {
+			return nil, err
+		}
+		books = append(books, &book)
+		byID[book.BookId] = &book
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(books) == 0 {
+		return nil, nil
+	}
+
+	rows, err = r.db.QueryxContext(ctx, `
+		select book_categories.book_id, c.category_id, c.name, c.parent_uuid, cat.name, c.created_at, c.updated_at
+			from book_categories
+		join categories c on book_categories.category_id = c.category_id
+		left join categories as cat ON c.parent_uuid = cat.category_id
+		where book_categories.book_id = any($1)`, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			bookID         string
+			category       pb.Category
+			parentUUID     sql.NullString
+			parentCategory sql.NullString
+		)
+		err = rows.Scan(&bookID, &category.CategoryId, &category.Name, &parentUUID, &parentCategory, &category.CreatedAt, &category.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		category.ParentUuid = parentUUID.String
+		category.ParentCategory = parentCategory.String
+
+		book, ok := byID[bookID]
+		if !ok {
+			continue
+		}
+		book.CategoryId = append(book.CategoryId, category.CategoryId)
+		book.Categories = append(book.Categories, &category)
+	}
+
+	return books, rows.Err()
+}
+
+// BatchGetAuthors returns the existing authors among ids in no particular order
+func (r *authorRepo) BatchGetAuthors(ctx context.Context, ids []string) ([]*pb.Author, error) {
+	rows, err := r.db.QueryxContext(ctx, `
+				SELECT author_id, name, created_at, updated_at, version FROM authors
+				WHERE author_id = any($1) AND deleted_at IS NULL`, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var authors []*pb.Author
+	for rows.Next() {
+		var author pb.Author
+		err = rows.Scan(&author.AuthorId, &author.Name, &author.CreatedAt, &author.UpdatedAt, &author.Version)
+		if err != nil {
+			return nil, err
+		}
+		authors = append(authors, &author)
+	}
+
+	return authors, rows.Err()
+}
+
+// BatchGetCategories returns the existing categories among ids in no particular order
+func (r *categoryRepo) BatchGetCategories(ctx context.Context, ids []string) ([]*pb.Category, error) {
+	rows, err := r.db.QueryxContext(ctx, `
+		SELECT cat.category_id, cat.name, cat.parent_uuid, cat2.name, cat.created_at, cat.updated_at, cat.version
+		FROM categories AS cat
+		LEFT JOIN categories AS cat2 ON cat.parent_uuid = cat2.category_id
+		WHERE cat.category_id = any($1) AND cat.deleted_at is null`, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var categories []*pb.Category
+	for rows.Next() {
+		var (
+			category       pb.Category
+			parentUUID     sql.NullString
+			parentCategory sql.NullString
+		)
+		err = rows.Scan(&category.CategoryId, &category.Name, &parentUUID, &parentCategory, &category.CreatedAt, &category.UpdatedAt, &category.Version)
+		if err != nil {
+			return nil, err
+		}
+		category.ParentUuid = parentUUID.String
+		category.ParentCategory = parentCategory.String
+
+		categories = append(categories, &category)
+	}
+
+	return categories, rows.Err()
+}
+
+// FindBooksByIdentifiers returns the books having either the isbn or the
+// external id, empty identifiers match nothing
+func (r *bookRepo) FindBooksByIdentifiers(ctx context.Context, isbn, externalID string) ([]*pb.Book, error) {
+	var ids []string
+	err := sqlx.SelectContext(ctx, r.db, &ids, `
+		SELECT book_id FROM books
+		WHERE deleted_at IS NULL AND (isbn = $1 OR external_id = $2)`,
+		stringToNullString(isbn), stringToNullString(externalID))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	return r.BatchGetBooks(ctx, ids)
+}
+
+// ScanBooks returns up to limit books ordered by id, starting after afterID
+func (r *bookRepo) ScanBooks(ctx context.Context, afterID string, limit int) ([]*pb.Book, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+	sb.Select("book_id")
+	sb.From("books")
+	sb.Where(sb.IsNull("deleted_at"))
+	if afterID != "" {
+		sb.Where(sb.GreaterThan("book_id", afterID))
+	}
+	sb.OrderBy("book_id")
+	sb.Limit(limit)
+
+	query, args := sb.BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	var ids []string
+	if err := sqlx.SelectContext(ctx, r.db, &ids, query, args...); err != nil {
+		return nil, err
+	}
+
+	books, err := r.BatchGetBooks(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(books, func(i, j int) bool {
+		return books[i].BookId < books[j].BookId
+	})
+
+	return books, nil
+}
@@ -1,14 +1,19 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
 	"time"
 
 	"github.com/huandu/go-sqlbuilder"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 
 	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	"github.com/abdullohsattorov/catalog-service/pkg/audit"
+	"github.com/abdullohsattorov/catalog-service/pkg/events"
 	"github.com/abdullohsattorov/catalog-service/pkg/utils"
+	"github.com/abdullohsattorov/catalog-service/storage/repo"
 )
 
 type bookRepo struct {
@@ -20,17 +25,32 @@ func NewBookRepo(db *sqlx.DB) *bookRepo {
 	return &bookRepo{db: db}
 }
 
-func (r *bookRepo) CreateBook(book pb.Book) (pb.Book, error) {
+func (r *bookRepo) CreateBook(ctx context.Context, book pb.Book) (pb.Book, error) {
+	var NewBook pb.Book
+
+	err := withTx(ctx, r.db, func(tx *sqlx.Tx) (err error) {
+		NewBook, err = createBook(ctx, tx, book)
+		return err
+	})
+	if err != nil {
+		return pb.Book{}, alreadyExists(err)
+	}
+
+	return NewBook, nil
+}
+
+func createBook(ctx context.Context, tx *sqlx.Tx, book pb.Book) (pb.Book, error) {
 	var id string
-	err := r.db.QueryRow(`
-        INSERT INTO books(book_id, name, author_id, price, created_at, updated_at)
-        VALUES ($1, $2, $3, $4, $5, $6) returning book_id`, book.BookId, book.Name, book.AuthorId, book.Price, time.Now().UTC(), time.Now().UTC()).Scan(&id)
+	err := tx.QueryRowContext(ctx, `
+        INSERT INTO books(book_id, name, author_id, price, isbn, external_id, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8) returning book_id`, book.BookId, book.Name, book.AuthorId, book.Price,
+		stringToNullString(book.Isbn), stringToNullString(book.ExternalId), time.Now().UTC(), time.Now().UTC()).Scan(&id)
 	if err != nil {
 		return pb.Book{}, err
 	}
 
 	for _, j := range book.CategoryId {
-		_, err = r.db.Exec(`
+		_, err = tx.ExecContext(ctx, `
         INSERT INTO book_categories(book_id, category_id)
         VALUES ($1, $2)`, book.BookId, j)
 		if err != nil {
@@ -38,27 +58,40 @@ func (r *bookRepo) CreateBook(book pb.Book) (pb.Book, error) {
 		}
 	}
 
-	var NewBook pb.Book
-
-	NewBook, err = r.GetBook(id)
+	if err = writeBookVersion(ctx, tx, id); err != nil {
+		return pb.Book{}, err
+	}
 
+	NewBook, err := getBook(ctx, tx, id)
 	if err != nil {
 		return pb.Book{}, err
 	}
 
+	if err = writeEvent(ctx, tx, events.AggregateBook, id, events.TypeCreated, NewBook); err != nil {
+		return pb.Book{}, err
+	}
+
+	if err = writeAudit(ctx, tx, events.AggregateBook, id, audit.OpCreate, nil, &NewBook); err != nil {
+		return pb.Book{}, err
+	}
+
 	return NewBook, nil
 }
 
-func (r *bookRepo) GetBook(id string) (pb.Book, error) {
+func (r *bookRepo) GetBook(ctx context.Context, id string) (pb.Book, error) {
+	return getBook(ctx, r.db, id)
+}
+
+func getBook(ctx context.Context, q sqlx.QueryerContext, id string) (pb.Book, error) {
 	var book pb.Book
-	err := r.db.QueryRow(`
-        SELECT book_id, name, author_id, price, created_at, updated_at FROM books
-        WHERE book_id=$1 and deleted_at is null`, id).Scan(&book.BookId, &book.Name, &book.AuthorId, &book.Price, &book.CreatedAt, &book.UpdatedAt)
+	err := q.QueryRowxContext(ctx, `
+        SELECT book_id, name, author_id, price, coalesce(isbn, ''), coalesce(external_id, ''), created_at, updated_at, version FROM books
+        WHERE book_id=$1 and deleted_at is null`, id).Scan(&book.BookId, &book.Name, &book.AuthorId, &book.Price, &book.Isbn, &book.ExternalId, &book.CreatedAt, &book.UpdatedAt, &book.Version)
 	if err != nil {
 		return pb.Book{}, err
 	}
 
-	rows, err := r.db.Queryx(`
+	rows, err := q.QueryxContext(ctx, `
 		select c.category_id, c.name, c.parent_uuid, cat.name, c.created_at, c.updated_at
 			from book_categories
 		join books b on book_categories.book_id = b.book_id
@@ -102,7 +135,7 @@ func (r *bookRepo) GetBook(id string) (pb.Book, error) {
 	return book, nil
 }
 
-func (r *bookRepo) ListBook(page, limit int64, filters map[string]string) ([]*pb.Book, int64, error) {
+func (r *bookRepo) ListBook(ctx context.Context, page, limit int64, filters map[string]string) ([]*pb.Book, int64, error) {
 	offset := (page - 1) * limit
 
 	sb := sqlbuilder.NewSelectBuilder()
@@ -126,7 +159,7 @@ func (r *bookRepo) ListBook(page, limit int64, filters map[string]string) ([]*pb
 	sb.Offset(int(offset))
 	query, args := sb.BuildWithFlavor(sqlbuilder.PostgreSQL)
 
-	rows, err := r.db.Queryx(query, args...)
+	rows, err := r.db.QueryxContext(ctx, query, args...)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -146,7 +179,7 @@ func (r *bookRepo) ListBook(page, limit int64, filters map[string]string) ([]*pb
 		if err != nil {
 			return nil, 0, err
 		}
-		book, _ := r.GetBook(byId.Id)
+		book, _ := r.GetBook(ctx, byId.Id)
 		books = append(books, &book)
 	}
 
@@ -169,7 +202,7 @@ func (r *bookRepo) ListBook(page, limit int64, filters map[string]string) ([]*pb
 
 	query, args = sbc.BuildWithFlavor(sqlbuilder.PostgreSQL)
 
-	rows, err = r.db.Queryx(query, args...)
+	rows, err = r.db.QueryxContext(ctx, query, args...)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -185,53 +218,175 @@ func (r *bookRepo) ListBook(page, limit int64, filters map[string]string) ([]*pb
 	return books, count, nil
 }
 
-func (r *bookRepo) UpdateBook(book pb.Book) (pb.Book, error) {
-	result, err := r.db.Exec(`UPDATE books SET name=$1, author_id=$2, price=$3, updated_at = $4 WHERE book_id=$5 and deleted_at is null`,
-		book.Name, book.AuthorId, book.Price, time.Now().UTC(), book.BookId,
+func (r *bookRepo) UpdateBook(ctx context.Context, update pb.UpdateBookReq) (pb.Book, error) {
+	var NewBook pb.Book
+
+	err := withTx(ctx, r.db, func(tx *sqlx.Tx) (err error) {
+		NewBook, err = updateBook(ctx, tx, update)
+		return err
+	})
+	if err != nil {
+		return pb.Book{}, alreadyExists(err)
+	}
+
+	return NewBook, nil
+}
+
+func updateBook(ctx context.Context, tx *sqlx.Tx, update pb.UpdateBookReq) (pb.Book, error) {
+	var (
+		book   = update.Book
+		fields = update.UpdateMask.GetPaths()
 	)
+
+	var (
+		oldPrice sql.NullFloat64
+		version  int64
+	)
+	err := tx.QueryRowContext(ctx, `SELECT price, version FROM books WHERE book_id=$1 and deleted_at is null FOR UPDATE`, book.BookId).
+		Scan(&oldPrice, &version)
 	if err != nil {
 		return pb.Book{}, err
 	}
 
-	if i, _ := result.RowsAffected(); i == 0 {
-		return pb.Book{}, sql.ErrNoRows
+	if version != book.Version {
+		return pb.Book{}, repo.ErrStaleVersion
 	}
 
-	r.db.Exec("delete from book_categories where book_id = $1", book.BookId)
+	before, err := getBook(ctx, tx, book.BookId)
+	if err != nil {
+		return pb.Book{}, err
+	}
 
-	for _, j := range book.CategoryId {
-		resultNext, newError := r.db.Exec(`insert into book_categories(book_id, category_id) values($1, $2)`,
-			book.BookId, j,
-		)
-		if newError != nil {
-			return pb.Book{}, err
+	ub := sqlbuilder.NewUpdateBuilder()
+	ub.Update("books")
+	ub.Set(ub.Assign("updated_at", time.Now().UTC()), ub.Incr("version"))
+	for _, field := range fields {
+		switch field {
+		case repo.FieldName:
+			ub.SetMore(ub.Assign("name", book.Name))
+		case repo.FieldAuthorID:
+			ub.SetMore(ub.Assign("author_id", book.AuthorId))
+		case repo.FieldPrice:
+			ub.SetMore(ub.Assign("price", book.Price))
+		case repo.FieldIsbn:
+			ub.SetMore(ub.Assign("isbn", stringToNullString(book.Isbn)))
+		case repo.FieldExternalID:
+			ub.SetMore(ub.Assign("external_id", stringToNullString(book.ExternalId)))
+		case repo.FieldCategoryID:
+			if err = setBookCategories(ctx, tx, book.BookId, book.CategoryId); err != nil {
+				return pb.Book{}, err
+			}
 		}
+	}
+	ub.Where(ub.Equal("book_id", book.BookId))
+
+	query, args := ub.BuildWithFlavor(sqlbuilder.PostgreSQL)
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		return pb.Book{}, err
+	}
+
+	if err = addBookCategories(ctx, tx, book.BookId, update.AddCategoryIds); err != nil {
+		return pb.Book{}, err
+	}
 
-		if i, _ := resultNext.RowsAffected(); i == 0 {
-			return pb.Book{}, sql.ErrNoRows
+	if len(update.RemoveCategoryIds) > 0 {
+		_, err = tx.ExecContext(ctx, `delete from book_categories where book_id = $1 and category_id = any($2)`,
+			book.BookId, pq.Array(update.RemoveCategoryIds))
+		if err != nil {
+			return pb.Book{}, err
 		}
 	}
 
-	var NewBook pb.Book
+	if err = writeBookVersion(ctx, tx, book.BookId); err != nil {
+		return pb.Book{}, err
+	}
 
-	NewBook, err = r.GetBook(book.BookId)
+	NewBook, err := getBook(ctx, tx, book.BookId)
+	if err != nil {
+		return pb.Book{}, err
+	}
 
+	err = writeEvent(ctx, tx, events.AggregateBook, book.BookId, events.TypeUpdated, NewBook)
 	if err != nil {
 		return pb.Book{}, err
 	}
 
+	if !oldPrice.Valid || float32(oldPrice.Float64) != NewBook.Price {
+		err = writeEvent(ctx, tx, events.AggregateBook, book.BookId, events.TypePriceChanged, priceChange{
+			BookId:   book.BookId,
+			OldPrice: float32(oldPrice.Float64),
+			NewPrice: NewBook.Price,
+		})
+		if err != nil {
+			return pb.Book{}, err
+		}
+	}
+
+	if err = writeAudit(ctx, tx, events.AggregateBook, book.BookId, audit.OpUpdate, &before, &NewBook); err != nil {
+		return pb.Book{}, err
+	}
+
 	return NewBook, nil
 }
 
-func (r *bookRepo) DeleteBook(id string) error {
-	result, err := r.db.Exec(`UPDATE books SET deleted_at = $1 WHERE book_id=$2`, time.Now().UTC(), id)
+// setBookCategories replaces every category link of the book
+func setBookCategories(ctx context.Context, tx *sqlx.Tx, bookID string, categoryIDs []string) error {
+	_, err := tx.ExecContext(ctx, "delete from book_categories where book_id = $1", bookID)
 	if err != nil {
 		return err
 	}
 
-	if i, _ := result.RowsAffected(); i == 0 {
-		return sql.ErrNoRows
+	return addBookCategories(ctx, tx, bookID, categoryIDs)
+}
+
+// addBookCategories links the book to categories it isn't linked to yet
+func addBookCategories(ctx context.Context, tx *sqlx.Tx, bookID string, categoryIDs []string) error {
+	for _, j := range categoryIDs {
+		_, err := tx.ExecContext(ctx, `insert into book_categories(book_id, category_id)
+			select $1, $2 where not exists (
+				select 1 from book_categories where book_id = $1 and category_id = $2
+			)`,
+			bookID, j,
+		)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
+
+func (r *bookRepo) DeleteBook(ctx context.Context, id string) error {
+	return withTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		if err := lockRow(ctx, tx, "books", "book_id", id); err != nil {
+			return err
+		}
+
+		before, err := getBook(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE books SET deleted_at = $1, version = version + 1 WHERE book_id=$2 and deleted_at is null`, time.Now().UTC(), id)
+		if err != nil {
+			return err
+		}
+
+		if err = writeBookVersion(ctx, tx, id); err != nil {
+			return err
+		}
+
+		if err = writeEvent(ctx, tx, events.AggregateBook, id, events.TypeDeleted, pb.ByIdReq{Id: id}); err != nil {
+			return err
+		}
+
+		return writeAudit(ctx, tx, events.AggregateBook, id, audit.OpDelete, &before, nil)
+	})
+}
+
+// priceChange is the payload of book price_changed events
+type priceChange struct {
+	BookId   string
+	OldPrice float32
+	NewPrice float32
+}
@@ -0,0 +1,90 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+)
+
+// writeBookVersion snapshots the current state of the book, it has to run in
+// the transaction changing the book
+func writeBookVersion(ctx context.Context, tx *sqlx.Tx, id string) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO book_versions(book_id, version, name, author_id, price, isbn, external_id, category_ids, deleted, created_at)
+		SELECT b.book_id, b.version, b.name, b.author_id, b.price, b.isbn, b.external_id,
+			array(SELECT bc.category_id FROM book_categories bc WHERE bc.book_id = b.book_id),
+			b.deleted_at IS NOT NULL, $2
+		FROM books b WHERE b.book_id = $1`, id, time.Now().UTC())
+
+	return err
+}
+
+func (r *bookRepo) GetBookVersion(ctx context.Context, id string, version int64) (pb.Book, error) {
+	return r.getBookVersion(ctx, `
+		SELECT v.book_id, v.version, v.name, v.author_id, v.price, coalesce(v.isbn, ''), coalesce(v.external_id, ''), v.category_ids, v.deleted, b.created_at, v.created_at
+		FROM book_versions v JOIN books b ON b.book_id = v.book_id
+		WHERE v.book_id = $1 AND v.version = $2`, id, version)
+}
+
+func (r *bookRepo) GetBookAsOf(ctx context.Context, id string, asOf time.Time) (pb.Book, error) {
+	return r.getBookVersion(ctx, `
+		SELECT v.book_id, v.version, v.name, v.author_id, v.price, coalesce(v.isbn, ''), coalesce(v.external_id, ''), v.category_ids, v.deleted, b.created_at, v.created_at
+		FROM book_versions v JOIN books b ON b.book_id = v.book_id
+		WHERE v.book_id = $1 AND v.created_at <= $2
+		ORDER BY v.version DESC LIMIT 1`, id, asOf.UTC())
+}
+
+// getBookVersion reads a snapshot selected by query. Categories are filled
+// as they are now, only their ids are versioned.
+func (r *bookRepo) getBookVersion(ctx context.Context, query string, args ...interface{}) (pb.Book, error) {
+	var (
+		book    pb.Book
+		price   sql.NullFloat64
+		deleted bool
+	)
+
+	err := r.db.QueryRowxContext(ctx, query, args...).Scan(&book.BookId, &book.Version, &book.Name, &book.AuthorId, &price,
+		&book.Isbn, &book.ExternalId, pq.Array(&book.CategoryId), &deleted, &book.CreatedAt, &book.UpdatedAt)
+	if err != nil {
+		return pb.Book{}, err
+	}
+
+	if deleted {
+		return pb.Book{}, sql.ErrNoRows
+	}
+	book.Price = float32(price.Float64)
+
+	rows, err := r.db.QueryxContext(ctx, `
+		select c.category_id, c.name, c.parent_uuid, cat.name, c.created_at, c.updated_at
+		from categories c
+		left join categories as cat ON c.parent_uuid = cat.category_id
+		where c.category_id = any($1)`, pq.Array(book.CategoryId))
+	if err != nil {
+		return pb.Book{}, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			category       pb.Category
+			parentUUID     sql.NullString
+			parentCategory sql.NullString
+		)
+		err = rows.Scan(&category.CategoryId, &category.Name, &parentUUID, &parentCategory, &category.CreatedAt, &category.UpdatedAt)
+		if err != nil {
+			return pb.Book{}, err
+		}
+
+		category.ParentUuid = parentUUID.String
+		category.ParentCategory = parentCategory.String
+
+		book.Categories = append(book.Categories, &category)
+	}
+
+	return book, rows.Err()
+}
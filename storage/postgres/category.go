@@ -1,13 +1,18 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"time"
 
+	"github.com/huandu/go-sqlbuilder"
 	"github.com/jmoiron/sqlx"
 
 	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	"github.com/abdullohsattorov/catalog-service/pkg/audit"
+	"github.com/abdullohsattorov/catalog-service/pkg/events"
+	"github.com/abdullohsattorov/catalog-service/storage/repo"
 )
 
 type categoryRepo struct {
@@ -19,41 +24,57 @@ func NewCategoryRepo(db *sqlx.DB) *categoryRepo {
 	return &categoryRepo{db: db}
 }
 
-func (r *categoryRepo) CreateCategory(category pb.Category) (pb.Category, error) {
+func (r *categoryRepo) CreateCategory(ctx context.Context, category pb.Category) (pb.Category, error) {
 	var (
-		parentID sql.NullString
-		id       string
+		parentID    sql.NullString
+		newCategory pb.Category
 	)
 	parentID = stringToNullString(category.ParentUuid)
 
-	err := r.db.QueryRow(`
+	err := withTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		var id string
+		err := tx.QueryRowContext(ctx, `
 	INSERT INTO categories(category_id, name, parent_uuid, created_at, updated_at) 
 	VALUES ($1, $2, $3, $4, $5) returning category_id`, category.CategoryId, category.Name, parentID, time.Now().UTC(), time.Now().UTC()).Scan(&id)
-	if err != nil {
-		return pb.Category{}, err
-	}
+		if err != nil {
+			return err
+		}
 
-	newCategory, err := r.GetCategory(id)
+		newCategory, err = getCategory(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		if err = writeEvent(ctx, tx, events.AggregateCategory, id, events.TypeCreated, newCategory); err != nil {
+			return err
+		}
+
+		return writeAudit(ctx, tx, events.AggregateCategory, id, audit.OpCreate, nil, &newCategory)
+	})
 	if err != nil {
-		return pb.Category{}, err
+		return pb.Category{}, alreadyExists(err)
 	}
 
 	return newCategory, nil
 }
 
-func (r *categoryRepo) GetCategory(id string) (pb.Category, error) {
+func (r *categoryRepo) GetCategory(ctx context.Context, id string) (pb.Category, error) {
+	return getCategory(ctx, r.db, id)
+}
+
+func getCategory(ctx context.Context, q sqlx.QueryerContext, id string) (pb.Category, error) {
 	var category pb.Category
 	var (
 		parentUUID     sql.NullString
 		parentCategory sql.NullString
 	)
 
-	err := r.db.QueryRow(`
-		SELECT cat.category_id, cat.name AS category_name, cat.parent_uuid, cat2.name AS parent_category, cat.created_at, cat.updated_at
+	err := q.QueryRowxContext(ctx, `
+		SELECT cat.category_id, cat.name AS category_name, cat.parent_uuid, cat2.name AS parent_category, cat.created_at, cat.updated_at, cat.version
 		FROM categories AS cat 
 		LEFT JOIN categories AS cat2 ON cat.parent_uuid = cat2.category_id
 		WHERE cat.category_id=$1 AND cat.deleted_at is null;
-		`, id).Scan(&category.CategoryId, &category.Name, &parentUUID, &parentCategory, &category.CreatedAt, &category.UpdatedAt)
+		`, id).Scan(&category.CategoryId, &category.Name, &parentUUID, &parentCategory, &category.CreatedAt, &category.UpdatedAt, &category.Version)
 	if err != nil {
 		return pb.Category{}, err
 	}
@@ -71,10 +92,10 @@ func (r *categoryRepo) GetCategory(id string) (pb.Category, error) {
 	return category, nil
 }
 
-func (r *categoryRepo) ListCategory(page, limit int64) ([]*pb.Category, int64, error) {
+func (r *categoryRepo) ListCategory(ctx context.Context, page, limit int64) ([]*pb.Category, int64, error) {
 	offset := (page - 1) * limit
-	rows, err := r.db.Queryx(`
-		SELECT cat.category_id, cat.name AS category_name, cat.parent_uuid, cat2.name AS parent_category, cat.created_at, cat.updated_at
+	rows, err := r.db.QueryxContext(ctx, `
+		SELECT cat.category_id, cat.name AS category_name, cat.parent_uuid, cat2.name AS parent_category, cat.created_at, cat.updated_at, cat.version
 		FROM categories AS cat 
 		LEFT JOIN categories AS cat2 ON cat.parent_uuid = cat2.category_id
 		WHERE cat.deleted_at is null 
@@ -95,7 +116,7 @@ func (r *categoryRepo) ListCategory(page, limit int64) ([]*pb.Category, int64, e
 	for rows.Next() {
 		var category pb.Category
 
-		err = rows.Scan(&category.CategoryId, &category.Name, &parentUUID, &parentCategory, &category.CreatedAt, &category.UpdatedAt)
+		err = rows.Scan(&category.CategoryId, &category.Name, &parentUUID, &parentCategory, &category.CreatedAt, &category.UpdatedAt, &category.Version)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -113,7 +134,7 @@ func (r *categoryRepo) ListCategory(page, limit int64) ([]*pb.Category, int64, e
 		categories = append(categories, &category)
 	}
 
-	err = r.db.QueryRow(`SELECT count(*) FROM categories where deleted_at is null`).Scan(&count)
+	err = r.db.QueryRowContext(ctx, `SELECT count(*) FROM categories where deleted_at is null`).Scan(&count)
 
 	if err != nil {
 		return nil, 0, err
@@ -122,25 +143,60 @@ func (r *categoryRepo) ListCategory(page, limit int64) ([]*pb.Category, int64, e
 	return categories, count, nil
 }
 
-func (r *categoryRepo) UpdateCategory(category pb.Category) (pb.Category, error) {
+func (r *categoryRepo) UpdateCategory(ctx context.Context, update pb.UpdateCategoryReq) (pb.Category, error) {
 	var (
-		parentID    sql.NullString
 		newCategory pb.Category
+		category    = update.Category
+		fields      = repo.CategoryFields
 	)
+	if len(update.UpdateMask.GetPaths()) > 0 {
+		fields = update.UpdateMask.Paths
+	}
+
+	ub := sqlbuilder.NewUpdateBuilder()
+	ub.Update("categories")
+	ub.Set(ub.Assign("updated_at", time.Now().UTC()), ub.Incr("version"))
+	for _, field := range fields {
+		switch field {
+		case repo.FieldName:
+			ub.SetMore(ub.Assign("name", category.Name))
+		case repo.FieldParentUUID:
+			ub.SetMore(ub.Assign("parent_uuid", stringToNullString(category.ParentUuid)))
+		}
+	}
+	ub.Where(ub.Equal("category_id", category.CategoryId), ub.Equal("version", category.Version), ub.IsNull("deleted_at"))
 
-	parentID = stringToNullString(category.ParentUuid)
+	query, args := ub.BuildWithFlavor(sqlbuilder.PostgreSQL)
 
-	result, err := r.db.Exec(`UPDATE categories SET name=$1, parent_uuid=$2, updated_at = $3 WHERE category_id=$4 and deleted_at is null`,
-		category.Name, parentID, time.Now().UTC(), category.CategoryId,
-	)
-	if err != nil {
-		return pb.Category{}, err
-	}
-	if i, _ := result.RowsAffected(); i == 0 {
-		return pb.Category{}, sql.ErrNoRows
-	}
+	err := withTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		if err := lockRow(ctx, tx, "categories", "category_id", category.CategoryId); err != nil {
+			return err
+		}
+
+		before, err := getCategory(ctx, tx, category.CategoryId)
+		if err != nil {
+			return err
+		}
+
+		result, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		if i, _ := result.RowsAffected(); i == 0 {
+			return staleOrMissing(ctx, tx, "categories", "category_id", category.CategoryId)
+		}
+
+		newCategory, err = getCategory(ctx, tx, category.CategoryId)
+		if err != nil {
+			return err
+		}
+
+		if err = writeEvent(ctx, tx, events.AggregateCategory, category.CategoryId, events.TypeUpdated, newCategory); err != nil {
+			return err
+		}
 
-	newCategory, err = r.GetCategory(category.CategoryId)
+		return writeAudit(ctx, tx, events.AggregateCategory, category.CategoryId, audit.OpUpdate, &before, &newCategory)
+	})
 	if err != nil {
 		return pb.Category{}, err
 	}
@@ -148,28 +204,39 @@ func (r *categoryRepo) UpdateCategory(category pb.Category) (pb.Category, error)
 	return newCategory, nil
 }
 
-func (r *categoryRepo) DeleteCategory(id string) error {
-	var count int
-	err := r.db.QueryRow(`select count(*) from categories where parent_uuid=$1 `, id).Scan(&count)
-	if err != nil {
-		return err
-	}
+func (r *categoryRepo) DeleteCategory(ctx context.Context, id string) error {
+	return withTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		var count int
+		err := tx.QueryRowContext(ctx, `select count(*) from categories where parent_uuid=$1 `, id).Scan(&count)
+		if err != nil {
+			return err
+		}
 
-	if count > 0 {
-		err1 := errors.New("this category has subcategories")
-		return err1
-	}
+		if count > 0 {
+			err1 := errors.New("this category has subcategories")
+			return err1
+		}
 
-	result, err := r.db.Exec(`UPDATE categories SET deleted_at = $1 WHERE category_id=$2`, time.Now().UTC(), id)
-	if err != nil {
-		return err
-	}
+		if err = lockRow(ctx, tx, "categories", "category_id", id); err != nil {
+			return err
+		}
 
-	if i, _ := result.RowsAffected(); i == 0 {
-		return sql.ErrNoRows
-	}
+		before, err := getCategory(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE categories SET deleted_at = $1, version = version + 1 WHERE category_id=$2`, time.Now().UTC(), id)
+		if err != nil {
+			return err
+		}
+
+		if err = writeEvent(ctx, tx, events.AggregateCategory, id, events.TypeDeleted, pb.ByIdReq{Id: id}); err != nil {
+			return err
+		}
 
-	return nil
+		return writeAudit(ctx, tx, events.AggregateCategory, id, audit.OpDelete, &before, nil)
+	})
 }
 
 func stringToNullString(s string) (ns sql.NullString) {
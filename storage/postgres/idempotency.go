@@ -0,0 +1,100 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/abdullohsattorov/catalog-service/storage/repo"
+)
+
+type idempotencyRepo struct {
+	db *sqlx.DB
+}
+
+// NewIdempotencyRepo ...
+func NewIdempotencyRepo(db *sqlx.DB) *idempotencyRepo {
+	return &idempotencyRepo{db: db}
+}
+
+// idempotencyPurgeBatch is how many expired keys are deleted at once
+const idempotencyPurgeBatch = 10000
+
+func (r *idempotencyRepo) ReserveIdempotencyKey(
+	ctx context.Context, key repo.IdempotencyKey, expiresBefore, lockedUntil time.Time,
+) (repo.IdempotencyKey, bool, error) {
+	var reserved string
+	now := time.Now().UTC()
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO idempotency_keys(key, method, caller, request_hash, created_at, locked_until)
+		VALUES ($1, $2, $7, $3, $4, $5)
+		ON CONFLICT (key, method, caller) DO UPDATE
+			SET request_hash = excluded.request_hash, response = NULL,
+				created_at = excluded.created_at, locked_until = excluded.locked_until
+			WHERE idempotency_keys.created_at < $6
+				OR (idempotency_keys.response IS NULL AND idempotency_keys.locked_until < $4)
+		RETURNING key`,
+		key.Key, key.Method, key.RequestHash, now, lockedUntil.UTC(), expiresBefore.UTC(), key.Caller).Scan(&reserved)
+	if err == nil {
+		return key, true, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return repo.IdempotencyKey{}, false, err
+	}
+
+	var stored repo.IdempotencyKey
+	err = r.db.QueryRowContext(ctx, `
+		SELECT key, method, caller, request_hash, response, created_at FROM idempotency_keys
+		WHERE key = $1 AND method = $2 AND caller = $3`, key.Key, key.Method, key.Caller).
+		Scan(&stored.Key, &stored.Method, &stored.Caller, &stored.RequestHash, &stored.Response, &stored.CreatedAt)
+	if err != nil {
+		return repo.IdempotencyKey{}, false, err
+	}
+
+	return stored, false, nil
+}
+
+func (r *idempotencyRepo) CompleteIdempotencyKey(ctx context.Context, key repo.IdempotencyKey, response []byte) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE idempotency_keys SET response = $4, locked_until = NULL
+		WHERE key = $1 AND method = $2 AND caller = $3`,
+		key.Key, key.Method, key.Caller, response)
+
+	return err
+}
+
+func (r *idempotencyRepo) ReleaseIdempotencyKey(ctx context.Context, key repo.IdempotencyKey) error {
+	_, err := r.db.ExecContext(ctx, `
+		DELETE FROM idempotency_keys WHERE key = $1 AND method = $2 AND caller = $3 AND response IS NULL`,
+		key.Key, key.Method, key.Caller)
+
+	return err
+}
+
+// PurgeIdempotencyKeys deletes keys created before the time, in batches so
+// a large backlog doesn't hold one long running delete
+func (r *idempotencyRepo) PurgeIdempotencyKeys(ctx context.Context, before time.Time) (int64, error) {
+	var purged int64
+	for {
+		res, err := r.db.ExecContext(ctx, `
+			DELETE FROM idempotency_keys WHERE (key, method, caller) IN (
+				SELECT key, method, caller FROM idempotency_keys WHERE created_at < $1 LIMIT $2
+			)`, before.UTC(), idempotencyPurgeBatch)
+		if err != nil {
+			return purged, err
+		}
+
+		n, err := res.RowsAffected()
+		if err != nil {
+			return purged, err
+		}
+		purged += n
+
+		if n < idempotencyPurgeBatch {
+			return purged, nil
+		}
+	}
+}
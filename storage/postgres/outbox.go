@@ -0,0 +1,225 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/huandu/go-sqlbuilder"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/abdullohsattorov/catalog-service/pkg/events"
+	"github.com/abdullohsattorov/catalog-service/pkg/utils"
+)
+
+type outboxRepo struct {
+	db *sqlx.DB
+}
+
+// NewOutboxRepo ...
+func NewOutboxRepo(db *sqlx.DB) *outboxRepo {
+	return &outboxRepo{db: db}
+}
+
+// outboxPurgeBatch bounds the events deleted by one statement of a purge
+const outboxPurgeBatch = 10000
+
+// PublishPending claims unpublished events for lease and publishes them
+// outside of any transaction, so a slow publisher holds no locks. Claims of
+// events which fail to publish are released, events claimed by a relay
+// which died are claimed again once the lease is over.
+func (r *outboxRepo) PublishPending(
+	ctx context.Context, limit int, lease time.Duration, publish func(ctx context.Context, events []events.Event) error,
+) (int, error) {
+	now := time.Now().UTC()
+
+	rows, err := r.db.QueryxContext(ctx, `
+		UPDATE outbox SET claimed_until = $1
+		WHERE id IN (
+			SELECT id FROM outbox
+			WHERE published_at IS NULL AND (claimed_until IS NULL OR claimed_until < $2)
+			ORDER BY id LIMIT $3 FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, aggregate_type, aggregate_id, event_type, payload, created_at`,
+		now.Add(lease), now, limit)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var pending []events.Event
+	for rows.Next() {
+		var event events.Event
+		err = rows.Scan(&event.ID, &event.AggregateType, &event.AggregateID, &event.EventType, &event.Payload, &event.CreatedAt)
+		if err != nil {
+			return 0, err
+		}
+		pending = append(pending, event)
+	}
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	if len(pending) == 0 {
+		return 0, nil
+	}
+
+	sort.Slice(pending, func(i, j int) bool {
+		return pending[i].ID < pending[j].ID
+	})
+	ids := make([]int64, 0, len(pending))
+	for _, event := range pending {
+		ids = append(ids, event.ID)
+	}
+
+	publishCtx, cancel := context.WithTimeout(ctx, lease)
+	err = publish(publishCtx, pending)
+	cancel()
+	if err != nil {
+		_, releaseErr := r.db.ExecContext(ctx, `
+			UPDATE outbox SET claimed_until = NULL WHERE id = ANY($1) AND published_at IS NULL`, pq.Array(ids))
+		if releaseErr != nil {
+			return 0, fmt.Errorf("%w, releasing the claim failed too: %v", err, releaseErr)
+		}
+		return 0, err
+	}
+
+	_, err = r.db.ExecContext(ctx, `
+		UPDATE outbox SET published_at = $1, claimed_until = NULL WHERE id = ANY($2)`,
+		time.Now().UTC(), pq.Array(ids))
+	if err != nil {
+		return 0, err
+	}
+
+	return len(pending), nil
+}
+
+// PurgePublished deletes sequenced events published before the time, in
+// batches so a large backlog doesn't hold one long running delete. The
+// greatest purged sequence is recorded, so change feeds resuming before it
+// are told events are missing.
+func (r *outboxRepo) PurgePublished(ctx context.Context, before time.Time) (int64, error) {
+	var purged int64
+	for {
+		var n int64
+		err := r.db.QueryRowContext(ctx, `
+			WITH deleted AS (
+				DELETE FROM outbox WHERE id IN (
+					SELECT id FROM outbox WHERE published_at < $1 AND sequence IS NOT NULL LIMIT $2
+				)
+				RETURNING sequence
+			), recorded AS (
+				UPDATE outbox_sequence
+				SET purged_through = greatest(purged_through, (SELECT coalesce(max(sequence), 0) FROM deleted))
+			)
+			SELECT count(*) FROM deleted`, before.UTC(), outboxPurgeBatch).Scan(&n)
+		if err != nil {
+			return purged, err
+		}
+		purged += n
+
+		if n < outboxPurgeBatch {
+			return purged, nil
+		}
+	}
+}
+
+// AssignSequences numbers the committed events which have no sequence yet,
+// in id order. Sequencers are serialized by the lock on the outbox_sequence
+// row, which is taken before the events are read, so every sequence given
+// out is greater than the ones already visible. Change feeds reading by
+// sequence never skip an event, while writers don't wait on each other.
+func (r *outboxRepo) AssignSequences(ctx context.Context) (int64, error) {
+	var assigned int64
+
+	err := withTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		var last int64
+		if err := tx.QueryRowxContext(ctx, `SELECT last FROM outbox_sequence FOR UPDATE`).Scan(&last); err != nil {
+			return err
+		}
+
+		res, err := tx.ExecContext(ctx, `
+			UPDATE outbox SET sequence = $1 + unsequenced.n
+			FROM (
+				SELECT id, row_number() OVER (ORDER BY id) AS n FROM outbox WHERE sequence IS NULL
+			) unsequenced
+			WHERE outbox.id = unsequenced.id`, last)
+		if err != nil {
+			return err
+		}
+
+		if assigned, err = res.RowsAffected(); err != nil || assigned == 0 {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, `UPDATE outbox_sequence SET last = $1`, last+assigned)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return assigned, nil
+}
+
+func (r *outboxRepo) ListEvents(ctx context.Context, afterSequence int64, aggregateTypes []string, limit int) ([]events.Event, error) {
+	sb := sqlbuilder.NewSelectBuilder()
+
+	sb.Select("id", "sequence", "aggregate_type", "aggregate_id", "event_type", "payload", "created_at")
+	sb.From("outbox")
+	sb.Where(sb.GreaterThan("sequence", afterSequence))
+	if len(aggregateTypes) > 0 {
+		sb.Where(sb.In("aggregate_type", utils.StringSliceToInterfaceSlice(aggregateTypes)...))
+	}
+	sb.OrderBy("sequence")
+	sb.Limit(limit)
+
+	query, args := sb.BuildWithFlavor(sqlbuilder.PostgreSQL)
+
+	rows, err := r.db.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var list []events.Event
+	for rows.Next() {
+		var event events.Event
+		err = rows.Scan(&event.ID, &event.Sequence, &event.AggregateType, &event.AggregateID, &event.EventType, &event.Payload, &event.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, event)
+	}
+
+	return list, rows.Err()
+}
+
+// SequenceRange returns the greatest sequence of a purged event and the
+// sequence of the latest event
+func (r *outboxRepo) SequenceRange(ctx context.Context) (int64, int64, error) {
+	var purgedThrough, last int64
+	err := r.db.QueryRowContext(ctx, `SELECT purged_through, last FROM outbox_sequence`).Scan(&purgedThrough, &last)
+
+	return purgedThrough, last, err
+}
+
+// writeEvent records the change in the outbox, it has to run in the
+// transaction making the change. The event is sequenced once committed,
+// see AssignSequences.
+func writeEvent(ctx context.Context, tx *sqlx.Tx, aggregateType, aggregateID, eventType string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO outbox(aggregate_type, aggregate_id, event_type, payload, created_at)
+		VALUES ($1, $2, $3, $4, $5)`,
+		aggregateType, aggregateID, eventType, body, time.Now().UTC())
+
+	return err
+}
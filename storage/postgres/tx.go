@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/abdullohsattorov/catalog-service/storage/repo"
+)
+
+// withTx runs fn in a transaction, committing it if fn succeeds
+func withTx(ctx context.Context, db *sqlx.DB, fn func(tx *sqlx.Tx) error) error {
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if err = fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// lockRow locks the row of the table, deleted or not, for the rest of the
+// transaction so what is read of it afterwards is the state the change
+// applies to. It does nothing when there is no such row.
+func lockRow(ctx context.Context, tx *sqlx.Tx, table, idColumn, id string) error {
+	_, err := tx.ExecContext(ctx, fmt.Sprintf(`SELECT 1 FROM %s WHERE %s = $1 FOR UPDATE`, table, idColumn), id)
+	return err
+}
+
+// staleOrMissing explains why an update of the row with optimistic version
+// check matched nothing: the row was changed in the meantime or it is gone
+func staleOrMissing(ctx context.Context, q sqlx.QueryerContext, table, idColumn, id string) error {
+	var exists bool
+	err := q.QueryRowxContext(ctx, fmt.Sprintf(`SELECT exists(SELECT 1 FROM %s WHERE %s = $1 AND deleted_at IS NULL)`, table, idColumn), id).
+		Scan(&exists)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return repo.ErrStaleVersion
+	}
+
+	return sql.ErrNoRows
+}
@@ -0,0 +1,258 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+	"github.com/abdullohsattorov/catalog-service/pkg/audit"
+	"github.com/abdullohsattorov/catalog-service/pkg/events"
+	"github.com/abdullohsattorov/catalog-service/storage/repo"
+)
+
+// uniqueViolation is the postgres error code of a violated unique constraint
+const uniqueViolation = "23505"
+
+// alreadyExists turns unique violations into repo.ErrAlreadyExists
+func alreadyExists(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == uniqueViolation {
+		return repo.ErrAlreadyExists
+	}
+
+	return err
+}
+
+// Upserts replace every field of an existing row and bring back deleted
+// ones. Replacing a live row needs its version, deleted rows are restored
+// whatever the version. xmax is zero for rows inserted by the statement, so
+// it tells creates and replaces apart. The existing row is locked and read
+// first, to audit the change against it.
+
+func (r *bookRepo) UpsertBook(ctx context.Context, book pb.Book) (pb.Book, bool, error) {
+	var (
+		NewBook  pb.Book
+		inserted bool
+	)
+
+	err := withTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		found, deleted, err := lockUpserted(ctx, tx, "books", "book_id", book.BookId)
+		if err != nil {
+			return err
+		}
+
+		var before *pb.Book
+		if found && !deleted {
+			if book.Version == 0 {
+				return repo.ErrVersionRequired
+			}
+
+			existing, err := getBook(ctx, tx, book.BookId)
+			if err != nil {
+				return err
+			}
+			before = &existing
+		}
+
+		now := time.Now().UTC()
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO books(book_id, name, author_id, price, isbn, external_id, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $7)
+			ON CONFLICT (book_id) DO UPDATE SET
+				name = excluded.name, author_id = excluded.author_id, price = excluded.price,
+				isbn = excluded.isbn, external_id = excluded.external_id,
+				updated_at = excluded.updated_at, deleted_at = NULL, version = books.version + 1
+			WHERE books.version = $8 OR ($8 = 0 AND books.deleted_at IS NOT NULL)
+			RETURNING xmax = 0`,
+			book.BookId, book.Name, book.AuthorId, book.Price,
+			stringToNullString(book.Isbn), stringToNullString(book.ExternalId), now, book.Version).Scan(&inserted)
+		if errors.Is(err, sql.ErrNoRows) {
+			return repo.ErrStaleVersion
+		}
+		if err != nil {
+			return err
+		}
+
+		if err = setBookCategories(ctx, tx, book.BookId, book.CategoryId); err != nil {
+			return err
+		}
+
+		if err = writeBookVersion(ctx, tx, book.BookId); err != nil {
+			return err
+		}
+
+		NewBook, err = getBook(ctx, tx, book.BookId)
+		if err != nil {
+			return err
+		}
+
+		if err = writeEvent(ctx, tx, events.AggregateBook, book.BookId, upsertEventType(inserted), NewBook); err != nil {
+			return err
+		}
+
+		return writeAudit(ctx, tx, events.AggregateBook, book.BookId, upsertOperation(inserted, deleted), before, &NewBook)
+	})
+	if err != nil {
+		return pb.Book{}, false, alreadyExists(err)
+	}
+
+	return NewBook, inserted, nil
+}
+
+func (r *authorRepo) UpsertAuthor(ctx context.Context, author pb.Author) (pb.Author, bool, error) {
+	var (
+		NewAuthor pb.Author
+		inserted  bool
+	)
+
+	err := withTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		found, deleted, err := lockUpserted(ctx, tx, "authors", "author_id", author.AuthorId)
+		if err != nil {
+			return err
+		}
+
+		var before *pb.Author
+		if found && !deleted {
+			if author.Version == 0 {
+				return repo.ErrVersionRequired
+			}
+
+			existing, err := getAuthor(ctx, tx, author.AuthorId)
+			if err != nil {
+				return err
+			}
+			before = &existing
+		}
+
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO authors(author_id, name, created_at, updated_at)
+			VALUES ($1, $2, $3, $3)
+			ON CONFLICT (author_id) DO UPDATE SET
+				name = excluded.name, updated_at = excluded.updated_at, deleted_at = NULL, version = authors.version + 1
+			WHERE authors.version = $4 OR ($4 = 0 AND authors.deleted_at IS NOT NULL)
+			RETURNING xmax = 0`,
+			author.AuthorId, author.Name, time.Now().UTC(), author.Version).Scan(&inserted)
+		if errors.Is(err, sql.ErrNoRows) {
+			return repo.ErrStaleVersion
+		}
+		if err != nil {
+			return err
+		}
+
+		NewAuthor, err = getAuthor(ctx, tx, author.AuthorId)
+		if err != nil {
+			return err
+		}
+
+		if err = writeEvent(ctx, tx, events.AggregateAuthor, author.AuthorId, upsertEventType(inserted), NewAuthor); err != nil {
+			return err
+		}
+
+		return writeAudit(ctx, tx, events.AggregateAuthor, author.AuthorId, upsertOperation(inserted, deleted), before, &NewAuthor)
+	})
+	if err != nil {
+		return pb.Author{}, false, alreadyExists(err)
+	}
+
+	return NewAuthor, inserted, nil
+}
+
+func (r *categoryRepo) UpsertCategory(ctx context.Context, category pb.Category) (pb.Category, bool, error) {
+	var (
+		newCategory pb.Category
+		inserted    bool
+	)
+
+	err := withTx(ctx, r.db, func(tx *sqlx.Tx) error {
+		found, deleted, err := lockUpserted(ctx, tx, "categories", "category_id", category.CategoryId)
+		if err != nil {
+			return err
+		}
+
+		var before *pb.Category
+		if found && !deleted {
+			if category.Version == 0 {
+				return repo.ErrVersionRequired
+			}
+
+			existing, err := getCategory(ctx, tx, category.CategoryId)
+			if err != nil {
+				return err
+			}
+			before = &existing
+		}
+
+		err = tx.QueryRowContext(ctx, `
+			INSERT INTO categories(category_id, name, parent_uuid, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $4)
+			ON CONFLICT (category_id) DO UPDATE SET
+				name = excluded.name, parent_uuid = excluded.parent_uuid,
+				updated_at = excluded.updated_at, deleted_at = NULL, version = categories.version + 1
+			WHERE categories.version = $5 OR ($5 = 0 AND categories.deleted_at IS NOT NULL)
+			RETURNING xmax = 0`,
+			category.CategoryId, category.Name, stringToNullString(category.ParentUuid), time.Now().UTC(), category.Version).
+			Scan(&inserted)
+		if errors.Is(err, sql.ErrNoRows) {
+			return repo.ErrStaleVersion
+		}
+		if err != nil {
+			return err
+		}
+
+		newCategory, err = getCategory(ctx, tx, category.CategoryId)
+		if err != nil {
+			return err
+		}
+
+		if err = writeEvent(ctx, tx, events.AggregateCategory, category.CategoryId, upsertEventType(inserted), newCategory); err != nil {
+			return err
+		}
+
+		return writeAudit(ctx, tx, events.AggregateCategory, category.CategoryId, upsertOperation(inserted, deleted), before, &newCategory)
+	})
+	if err != nil {
+		return pb.Category{}, false, alreadyExists(err)
+	}
+
+	return newCategory, inserted, nil
+}
+
+func upsertEventType(inserted bool) string {
+	if inserted {
+		return events.TypeCreated
+	}
+
+	return events.TypeUpdated
+}
+
+func upsertOperation(inserted, deleted bool) string {
+	switch {
+	case inserted:
+		return audit.OpCreate
+	case deleted:
+		return audit.OpRestore
+	default:
+		return audit.OpUpdate
+	}
+}
+
+// lockUpserted locks the row an upsert replaces, deleted or not, and tells
+// whether there is one and whether it is deleted
+func lockUpserted(ctx context.Context, tx *sqlx.Tx, table, idColumn, id string) (found, deleted bool, err error) {
+	err = tx.QueryRowxContext(ctx, fmt.Sprintf(`SELECT deleted_at IS NOT NULL FROM %s WHERE %s = $1 FOR UPDATE`, table, idColumn), id).
+		Scan(&deleted)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, false, nil
+	}
+	if err != nil {
+		return false, false, err
+	}
+
+	return true, deleted, nil
+}
@@ -0,0 +1,9 @@
+package repo
+
+import "context"
+
+import pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+
+type AuditStorageI interface {
+	ListAuditEvents(ctx context.Context, req pb.ListAuditEventsReq) ([]*pb.AuditEvent, int64, error)
+}
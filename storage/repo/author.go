@@ -1,11 +1,19 @@
 package repo
 
+import "context"
+
 import pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
 
 type AuthorStorageI interface {
-	CreateAuthor(author pb.Author) (pb.Author, error)
-	GetAuthor(id string) (pb.Author, error)
-	ListAuthor(page, limit int64) ([]*pb.Author, int64, error)
-	UpdateAuthor(update pb.Author) (pb.Author, error)
-	DeleteAuthor(id string) error
+	CreateAuthor(ctx context.Context, author pb.Author) (pb.Author, error)
+	GetAuthor(ctx context.Context, id string) (pb.Author, error)
+	ListAuthor(ctx context.Context, page, limit int64) ([]*pb.Author, int64, error)
+	UpdateAuthor(ctx context.Context, update pb.UpdateAuthorReq) (pb.Author, error)
+	DeleteAuthor(ctx context.Context, id string) error
+	BatchGetAuthors(ctx context.Context, ids []string) ([]*pb.Author, error)
+	// UpsertAuthor creates the author or replaces it, the bool tells if it was created
+	UpsertAuthor(ctx context.Context, author pb.Author) (pb.Author, bool, error)
 }
+
+// AuthorFields are the fields UpdateAuthor changes when the mask is empty
+var AuthorFields = []string{FieldName}
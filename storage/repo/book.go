@@ -1,11 +1,40 @@
 package repo
 
-import pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+import (
+	"context"
+	"time"
+
+	pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
+)
 
 type BookStorageI interface {
-	CreateBook(book pb.Book) (pb.Book, error)
-	GetBook(id string) (pb.Book, error)
-	ListBook(page, limit int64, filters map[string]string) ([]*pb.Book, int64, error)
-	UpdateBook(update pb.Book) (pb.Book, error)
-	DeleteBook(id string) error
+	CreateBook(ctx context.Context, book pb.Book) (pb.Book, error)
+	GetBook(ctx context.Context, id string) (pb.Book, error)
+	ListBook(ctx context.Context, page, limit int64, filters map[string]string) ([]*pb.Book, int64, error)
+	UpdateBook(ctx context.Context, update pb.UpdateBookReq) (pb.Book, error)
+	DeleteBook(ctx context.Context, id string) error
+	GetBookVersion(ctx context.Context, id string, version int64) (pb.Book, error)
+	GetBookAsOf(ctx context.Context, id string, asOf time.Time) (pb.Book, error)
+	BatchGetBooks(ctx context.Context, ids []string) ([]*pb.Book, error)
+	BatchCreateBooks(ctx context.Context, books []pb.Book, allOrNothing bool) ([]pb.Book, []error)
+	BatchUpdateBooks(ctx context.Context, updates []pb.UpdateBookReq, allOrNothing bool) ([]pb.Book, []error)
+	FindBooksByIdentifiers(ctx context.Context, isbn, externalID string) ([]*pb.Book, error)
+	ScanBooks(ctx context.Context, afterID string, limit int) ([]*pb.Book, error)
+	// UpsertBook creates the book or replaces it, the bool tells if it was created
+	UpsertBook(ctx context.Context, book pb.Book) (pb.Book, bool, error)
 }
+
+// Fields of catalog entities which can be listed in an update mask
+const (
+	FieldName       = "Name"
+	FieldAuthorID   = "AuthorId"
+	FieldPrice      = "Price"
+	FieldCategoryID = "CategoryId"
+	FieldParentUUID = "ParentUuid"
+	FieldIsbn       = "Isbn"
+	FieldExternalID = "ExternalId"
+)
+
+// BookFields are the fields of a book which can be updated. UpdateBook
+// changes only the fields in the mask, callers fill it in.
+var BookFields = []string{FieldName, FieldAuthorID, FieldPrice, FieldCategoryID, FieldIsbn, FieldExternalID}
@@ -1,11 +1,19 @@
 package repo
 
+import "context"
+
 import pb "github.com/abdullohsattorov/catalog-service/genproto/catalog_service"
 
 type CategoryStorageI interface {
-	CreateCategory(category pb.Category) (pb.Category, error)
-	GetCategory(id string) (pb.Category, error)
-	ListCategory(page, limit int64) ([]*pb.Category, int64, error)
-	UpdateCategory(category pb.Category) (pb.Category, error)
-	DeleteCategory(id string) error
+	CreateCategory(ctx context.Context, category pb.Category) (pb.Category, error)
+	GetCategory(ctx context.Context, id string) (pb.Category, error)
+	ListCategory(ctx context.Context, page, limit int64) ([]*pb.Category, int64, error)
+	UpdateCategory(ctx context.Context, update pb.UpdateCategoryReq) (pb.Category, error)
+	DeleteCategory(ctx context.Context, id string) error
+	BatchGetCategories(ctx context.Context, ids []string) ([]*pb.Category, error)
+	// UpsertCategory creates the category or replaces it, the bool tells if it was created
+	UpsertCategory(ctx context.Context, category pb.Category) (pb.Category, bool, error)
 }
+
+// CategoryFields are the fields UpdateCategory changes when the mask is empty
+var CategoryFields = []string{FieldName, FieldParentUUID}
@@ -0,0 +1,19 @@
+package repo
+
+import "errors"
+
+// ErrStaleVersion is returned by updates based on a version which is not
+// the current one anymore
+var ErrStaleVersion = errors.New("stale version")
+
+// ErrBatchAborted is returned for the items of an all or nothing batch which
+// were rolled back because another item failed
+var ErrBatchAborted = errors.New("batch aborted")
+
+// ErrVersionRequired is returned by upserts replacing an existing entity
+// without the version they are based on
+var ErrVersionRequired = errors.New("version required")
+
+// ErrAlreadyExists is returned by creates of an entity whose id or other
+// unique field is already taken
+var ErrAlreadyExists = errors.New("already exists")
@@ -0,0 +1,32 @@
+package repo
+
+import (
+	"context"
+
+	"time"
+)
+
+// IdempotencyKey is a request made with an idempotency key, Response is nil
+// while the request is being handled. Keys are scoped to the method and to
+// the Caller which sent them.
+type IdempotencyKey struct {
+	Key         string
+	Method      string
+	Caller      string
+	RequestHash string
+	Response    []byte
+	CreatedAt   time.Time
+}
+
+type IdempotencyStorageI interface {
+	// ReserveIdempotencyKey stores the key, locked for its request until
+	// lockedUntil. A stored key is taken over when it is older than
+	// expiresBefore, or when its request never completed and the lock ran
+	// out. It returns the stored key and whether it was reserved by this call.
+	ReserveIdempotencyKey(ctx context.Context, key IdempotencyKey, expiresBefore, lockedUntil time.Time) (IdempotencyKey, bool, error)
+	CompleteIdempotencyKey(ctx context.Context, key IdempotencyKey, response []byte) error
+	// ReleaseIdempotencyKey drops a reserved key whose request failed, so it can be retried
+	ReleaseIdempotencyKey(ctx context.Context, key IdempotencyKey) error
+	// PurgeIdempotencyKeys deletes keys created before the time and returns how many
+	PurgeIdempotencyKeys(ctx context.Context, before time.Time) (int64, error)
+}
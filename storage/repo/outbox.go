@@ -0,0 +1,18 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/abdullohsattorov/catalog-service/pkg/events"
+)
+
+type OutboxStorageI interface {
+	PublishPending(
+		ctx context.Context, limit int, lease time.Duration, publish func(ctx context.Context, events []events.Event) error,
+	) (int, error)
+	PurgePublished(ctx context.Context, before time.Time) (int64, error)
+	AssignSequences(ctx context.Context) (int64, error)
+	ListEvents(ctx context.Context, afterSequence int64, aggregateTypes []string, limit int) ([]events.Event, error)
+	SequenceRange(ctx context.Context) (purgedThrough, last int64, err error)
+}
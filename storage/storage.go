@@ -12,6 +12,9 @@ type IStorage interface {
 	Book() repo.BookStorageI
 	Author() repo.AuthorStorageI
 	Category() repo.CategoryStorageI
+	Outbox() repo.OutboxStorageI
+	Audit() repo.AuditStorageI
+	Idempotency() repo.IdempotencyStorageI
 }
 
 type storagePg struct {
@@ -19,6 +22,9 @@ type storagePg struct {
 	bookRepo     repo.BookStorageI
 	authorRepo   repo.AuthorStorageI
 	categoryRepo repo.CategoryStorageI
+	outboxRepo   repo.OutboxStorageI
+	auditRepo    repo.AuditStorageI
+	idemRepo     repo.IdempotencyStorageI
 }
 
 // NewStoragePg ...
@@ -28,6 +34,9 @@ func NewStoragePg(db *sqlx.DB) *storagePg {
 		bookRepo:     postgres.NewBookRepo(db),
 		authorRepo:   postgres.NewAuthorRepo(db),
 		categoryRepo: postgres.NewCategoryRepo(db),
+		outboxRepo:   postgres.NewOutboxRepo(db),
+		auditRepo:    postgres.NewAuditRepo(db),
+		idemRepo:     postgres.NewIdempotencyRepo(db),
 	}
 }
 
@@ -42,3 +51,15 @@ func (s storagePg) Author() repo.AuthorStorageI {
 func (s storagePg) Category() repo.CategoryStorageI {
 	return s.categoryRepo
 }
+
+func (s storagePg) Outbox() repo.OutboxStorageI {
+	return s.outboxRepo
+}
+
+func (s storagePg) Audit() repo.AuditStorageI {
+	return s.auditRepo
+}
+
+func (s storagePg) Idempotency() repo.IdempotencyStorageI {
+	return s.idemRepo
+}